@@ -0,0 +1,81 @@
+// neurocloser/backend/cmd/importer/main.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/edufilhocruz/neurocloser/backend/database"
+	"github.com/edufilhocruz/neurocloser/backend/importer"
+)
+
+func main() {
+	dir := flag.String("dir", "./dados", "Diretório com os arquivos .zip dos Dados Abertos CNPJ")
+	tabelas := flag.String("tabelas", "", "Tabelas a importar, separadas por vírgula (padrão: todas)")
+	batch := flag.Int("batch", 50000, "Quantidade de linhas por transação (um COPY por lote)")
+	workers := flag.Int("workers", runtime.NumCPU(), "Quantidade de arquivos .zip processados em paralelo")
+	tolerancia := flag.Float64("tolerancia", 0.05, "Variação máxima de linhas em relação à carga atual (0.05 = 5%)")
+	referencia := flag.String("referencia", "", "Mês de referência do dump, AAAA-MM (padrão: o nome do diretório)")
+	reiniciar := flag.Bool("reiniciar", false, "Descarta o progresso de uma importação interrompida e recomeça do zero")
+	rollback := flag.Bool("rollback", false, "Restaura a geração anterior das tabelas em vez de importar")
+	backfill := flag.Bool("backfill", false, "Preenche na geração atual os dados derivados que as migrations criam vazios, em vez de importar")
+	flag.Parse()
+
+	var names []string
+	if *tabelas != "" {
+		for _, name := range strings.Split(*tabelas, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	}
+
+	// Inicializa a conexão com o banco de dados
+	database.InitDB()
+	defer database.CloseDB()
+
+	// O importador depende das tabelas criadas pelas migrations
+	if err := database.MigrateUp(database.DB); err != nil {
+		log.Fatalf("Falha ao aplicar as migrations: %v", err)
+	}
+
+	imp := importer.NewImporter(database.DB, importer.Options{
+		Dir:       *dir,
+		BatchSize: *batch,
+		Workers:   *workers,
+		Tolerance: *tolerancia,
+		Reference: *referencia,
+		Restart:   *reiniciar,
+		// O servidor GraphQL repassa o progresso à subscription progressoImportacao
+		Progress: importer.NewProgressNotifier(database.DB),
+	})
+
+	if *rollback {
+		if err := imp.Rollback(names); err != nil {
+			log.Fatalf("Falha no rollback: %v", err)
+		}
+		fmt.Println("Rollback concluído.")
+		return
+	}
+
+	if *backfill {
+		if err := imp.Backfill(); err != nil {
+			log.Fatalf("Falha no preenchimento: %v", err)
+		}
+		fmt.Println("Preenchimento concluído.")
+		return
+	}
+
+	start := time.Now()
+	report, err := imp.Run(names)
+	if len(report) > 0 {
+		fmt.Print(report.String())
+	}
+	if err != nil {
+		log.Fatalf("Falha na importação: %v", err)
+	}
+
+	fmt.Printf("Importação concluída em %v\n", time.Since(start).Round(time.Second))
+}
@@ -6,15 +6,22 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/edufilhocruz/neurocloser/backend/database"
 	"github.com/edufilhocruz/neurocloser/backend/dataloaders"
 	"github.com/edufilhocruz/neurocloser/backend/graphql"
 	"github.com/edufilhocruz/neurocloser/backend/graphql/generated"
+	"github.com/edufilhocruz/neurocloser/backend/importer"
 	"github.com/edufilhocruz/neurocloser/backend/repositories"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func main() {
@@ -22,11 +29,47 @@ func main() {
 	database.InitDB()
 	defer database.CloseDB() // Garante que a conexão será fechada ao final do programa
 
+	// Aplica as migrations pendentes (pode ser desativado com MIGRATE_ON_STARTUP=false e feito via cmd/migrate)
+	if os.Getenv("MIGRATE_ON_STARTUP") != "false" {
+		if err := database.MigrateUp(database.DB); err != nil {
+			log.Fatalf("Falha ao aplicar as migrations: %v", err)
+		}
+	}
+
 	// Inicializa TODOS os repositórios necessários
 	empresaRepo := repositories.NewEmpresaRepository(database.DB)
 	estabelecimentoRepo := repositories.NewEstabelecimentoRepository(database.DB)
 	socioRepo := repositories.NewSocioRepository(database.DB)
+	simplesRepo := repositories.NewSimplesRepository(database.DB)
 	cnaeRepo := repositories.NewCNAERepository(database.DB)
+	mudancaRepo := repositories.NewMudancaRepository(database.DB)
+	versaoDadosRepo := repositories.NewVersaoDadosRepository(database.DB)
+
+	// Recebe o progresso publicado pelo importador (cmd/importer) para a subscription progressoImportacao
+	progressBroker, err := importer.NewProgressBroker(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.Printf("Acompanhamento de importação desativado: %v", err)
+	}
+
+	// Máximo de CNPJs por consulta em lote (prospeccaoPorCnpjs)
+	maxCNPJsLote := graphql.DefaultMaxCNPJsLote
+	if v := os.Getenv("LOTE_CNPJS_MAX"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatalf("LOTE_CNPJS_MAX inválido: '%s'", v)
+		}
+		maxCNPJsLote = n
+	}
+
+	// Similaridade mínima padrão de empresasSimilares (de 0 a 1)
+	limiarSimilaridade := graphql.DefaultLimiarSimilaridade
+	if v := os.Getenv("SIMILARIDADE_LIMIAR"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f <= 0 || f > 1 {
+			log.Fatalf("SIMILARIDADE_LIMIAR inválido: '%s'", v)
+		}
+		limiarSimilaridade = f
+	}
 
 	// Cria uma nova instância de resolver e injeta os repositórios
 	resolver := &graphql.Resolver{
@@ -34,15 +77,45 @@ func main() {
 		EmpresaRepo:         empresaRepo,
 		EstabelecimentoRepo: estabelecimentoRepo,
 		SocioRepo:           socioRepo,
+		SimplesRepo:         simplesRepo,
 		CNAERepo:            cnaeRepo,
+		MudancaRepo:         mudancaRepo,
+		VersaoDadosRepo:     versaoDadosRepo,
+		ProgressBroker:      progressBroker,
+		MaxCNPJsLote:        maxCNPJsLote,
+		LimiarSimilaridade:  limiarSimilaridade,
 	}
 
 	// Configuração do Servidor GraphQL
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver, Directives: generated.DirectiveRoot{}}))
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolver, Directives: generated.DirectiveRoot{}}))
+	// Websocket para as subscriptions; o ping mantém a conexão aberta durante cargas longas
+	srv.AddTransport(transport.Websocket{KeepAlivePingInterval: 10 * time.Second})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](100)})
+	// Erros de validação (ex.: CNPJ inválido) saem com extensions.code = "VALIDACAO"
+	srv.SetErrorPresenter(graphql.ErrorPresenter)
+	// Toda resposta leva a versão dos dados em extensions.dataVersion
+	srv.Use(&graphql.DataVersionExtension{Repo: versaoDadosRepo})
 
 	// Aplica o middleware do Dataloader ao servidor GraphQL
 	// O middleware deve vir ANTES do servidor GraphQL para que os loaders estejam no contexto.
-	http.Handle("/query", dataloaders.DataloaderMiddleware(empresaRepo, socioRepo, cnaeRepo)(srv))
+	http.Handle("/query", dataloaders.DataloaderMiddleware(dataloaders.Repositories{
+		Empresa:          empresaRepo,
+		Estabelecimento:  estabelecimentoRepo,
+		Socio:            socioRepo,
+		Simples:          simplesRepo,
+		CNAE:             cnaeRepo,
+		NaturezaJuridica: repositories.NewNaturezaJuridicaRepository(database.DB),
+		Qualificacao:     repositories.NewQualificacaoRepository(database.DB),
+		Pais:             repositories.NewPaisRepository(database.DB),
+		Municipio:        repositories.NewMunicipioRepository(database.DB),
+		Motivo:           repositories.NewMotivoRepository(database.DB),
+	})(srv))
 
 	// Rota para o Playground GraphQL (não precisa do dataloader middleware para o playground)
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
@@ -0,0 +1,58 @@
+// neurocloser/backend/cmd/migrate/main.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/edufilhocruz/neurocloser/backend/database"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: migrate [-steps N] [-drop-data] up|down|status")
+		flag.PrintDefaults()
+	}
+	steps := flag.Int("steps", 1, "Quantidade de migrations a desfazer com 'down'")
+	dropData := flag.Bool("drop-data", false, "Permite que 'down' desfaça migrations que apagam dados (0001 apaga as tabelas da Receita)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Inicializa a conexão com o banco de dados
+	database.InitDB()
+	defer database.CloseDB()
+
+	switch flag.Arg(0) {
+	case "up":
+		if err := database.MigrateUp(database.DB); err != nil {
+			log.Fatalf("Falha ao aplicar as migrations: %v", err)
+		}
+		fmt.Println("Migrations aplicadas.")
+	case "down":
+		if err := database.MigrateDown(database.DB, *steps, *dropData); err != nil {
+			log.Fatalf("Falha ao desfazer as migrations: %v", err)
+		}
+		fmt.Printf("%d migration(s) desfeita(s).\n", *steps)
+	case "status":
+		migrations, err := database.MigrationStatus(database.DB)
+		if err != nil {
+			log.Fatalf("Falha ao consultar as migrations: %v", err)
+		}
+		for _, mig := range migrations {
+			status := "pendente"
+			if mig.AppliedAt != nil {
+				status = "aplicada em " + mig.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-30s %s\n", mig.Version, mig.Name, status)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
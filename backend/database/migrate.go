@@ -0,0 +1,218 @@
+// neurocloser/backend/database/migrate.go
+package database
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// migrationFiles guarda os arquivos SQL das migrations dentro do binário.
+// Cada versão tem um par NNNN_nome.up.sql / NNNN_nome.down.sql.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationFilePattern reconhece o nome dos arquivos de migration.
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// dropDataMarker, na primeira linha de um .down.sql, marca as migrations que apagam dados que não são
+// recriados por elas (ex.: as tabelas da Receita adotadas de bancos anteriores às migrations). MigrateDown
+// só desfaz essas migrations com dropData.
+const dropDataMarker = "-- migrate:drop-data"
+
+// migrationLockID identifica o advisory lock que impede duas migrações simultâneas
+// (ex.: o servidor e o importador subindo ao mesmo tempo).
+const migrationLockID = 7267001
+
+// Migration é uma versão do schema embutida no binário.
+type Migration struct {
+	Version   int
+	Name      string
+	Up        string
+	Down      string
+	DropsData bool       // O .down.sql tem o dropDataMarker
+	AppliedAt *time.Time // nil se ainda não foi aplicada
+}
+
+// createSchemaMigrationsTable cria a tabela que registra as versões aplicadas.
+const createSchemaMigrationsTable = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)
+`
+
+// loadMigrations lê as migrations embutidas, em ordem crescente de versão.
+func loadMigrations() ([]*Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler as migrations embutidas: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		m := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if m == nil {
+			return nil, fmt.Errorf("nome de migration inválido: '%s'", entry.Name())
+		}
+		version, _ := strconv.Atoi(m[1])
+		content, err := migrationFiles.ReadFile("migrations/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao ler a migration '%s': %w", entry.Name(), err)
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("a versão %d tem nomes diferentes: '%s' e '%s'", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(content)
+		} else {
+			mig.Down = string(content)
+			mig.DropsData = strings.HasPrefix(mig.Down, dropDataMarker)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("a migration %04d_%s não tem arquivo .up.sql", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// MigrationStatus retorna todas as migrations embutidas, indicando quando cada uma foi aplicada.
+func MigrationStatus(db *sqlx.DB) ([]*Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(createSchemaMigrationsTable); err != nil {
+		return nil, fmt.Errorf("erro ao criar a tabela schema_migrations: %w", err)
+	}
+
+	var applied []struct {
+		Version   int       `db:"version"`
+		AppliedAt time.Time `db:"applied_at"`
+	}
+	if err := db.Select(&applied, "SELECT version, applied_at FROM schema_migrations"); err != nil {
+		return nil, fmt.Errorf("erro ao consultar as migrations aplicadas: %w", err)
+	}
+	appliedAt := map[int]time.Time{}
+	for _, a := range applied {
+		appliedAt[a.Version] = a.AppliedAt
+	}
+	for _, mig := range migrations {
+		if t, ok := appliedAt[mig.Version]; ok {
+			mig.AppliedAt = &t
+		}
+	}
+	return migrations, nil
+}
+
+// MigrateUp aplica, em ordem, todas as migrations ainda não aplicadas. Cada uma roda em sua própria transação.
+func MigrateUp(db *sqlx.DB) error {
+	return withMigrationLock(db, func() error {
+		migrations, err := MigrationStatus(db)
+		if err != nil {
+			return err
+		}
+		for _, mig := range migrations {
+			if mig.AppliedAt != nil {
+				continue
+			}
+			log.Printf("Aplicando migration %04d_%s...", mig.Version, mig.Name)
+			err := inMigrationTx(db, mig.Up, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", mig.Version, mig.Name)
+			if err != nil {
+				return fmt.Errorf("erro na migration %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// MigrateDown desfaz as últimas 'steps' migrations aplicadas, da mais recente para a mais antiga.
+// Se alguma delas apaga dados (ver dropDataMarker) e dropData é false, nenhuma é desfeita.
+func MigrateDown(db *sqlx.DB, steps int, dropData bool) error {
+	return withMigrationLock(db, func() error {
+		migrations, err := MigrationStatus(db)
+		if err != nil {
+			return err
+		}
+		var undo []*Migration
+		for i := len(migrations) - 1; i >= 0 && len(undo) < steps; i-- {
+			if migrations[i].AppliedAt != nil {
+				undo = append(undo, migrations[i])
+			}
+		}
+		for _, mig := range undo {
+			if mig.Down == "" {
+				return fmt.Errorf("a migration %04d_%s não tem arquivo .down.sql", mig.Version, mig.Name)
+			}
+			if mig.DropsData && !dropData {
+				return fmt.Errorf("a migration %04d_%s apaga dados que podem não ter sido criados por ela (ver o .down.sql); use -drop-data para confirmar", mig.Version, mig.Name)
+			}
+		}
+
+		for _, mig := range undo {
+			log.Printf("Desfazendo migration %04d_%s...", mig.Version, mig.Name)
+			err := inMigrationTx(db, mig.Down, "DELETE FROM schema_migrations WHERE version = $1", mig.Version)
+			if err != nil {
+				return fmt.Errorf("erro ao desfazer a migration %04d_%s: %w", mig.Version, mig.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// inMigrationTx executa o SQL da migration e o registro em schema_migrations na mesma transação.
+func inMigrationTx(db *sqlx.DB, script string, record string, args ...interface{}) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(script); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// withMigrationLock executa fn segurando um advisory lock de sessão.
+// O lock fica preso a uma conexão, por isso usamos uma conexão dedicada do pool.
+func withMigrationLock(db *sqlx.DB, fn func() error) error {
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		return fmt.Errorf("erro ao obter conexão para as migrations: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("erro ao obter o lock das migrations: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+
+	return fn()
+}
@@ -0,0 +1,22 @@
+package database
+
+import "testing"
+
+func TestLoadMigrationsDropsData(t *testing.T) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	for i, mig := range migrations {
+		if mig.Version != i+1 {
+			t.Errorf("migration %04d_%s na posição %d, esperado a versão %d", mig.Version, mig.Name, i, i+1)
+		}
+		if mig.Down == "" {
+			t.Errorf("migration %04d_%s sem .down.sql", mig.Version, mig.Name)
+		}
+		// Só a 0001 adota tabelas que podem ter sido criadas fora das migrations.
+		if want := mig.Version == 1; mig.DropsData != want {
+			t.Errorf("migration %04d_%s: DropsData = %v, esperado %v", mig.Version, mig.Name, mig.DropsData, want)
+		}
+	}
+}
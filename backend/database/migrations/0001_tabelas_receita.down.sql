@@ -0,0 +1,13 @@
+-- migrate:drop-data
+-- 0001 adota tabelas já existentes (CREATE TABLE IF NOT EXISTS), então estes DROPs podem apagar dados que
+-- a migration não criou; cmd/migrate só desfaz esta migration com -drop-data.
+DROP TABLE IF EXISTS motivo;
+DROP TABLE IF EXISTS municipio;
+DROP TABLE IF EXISTS pais;
+DROP TABLE IF EXISTS qualificacao;
+DROP TABLE IF EXISTS natureza_juridica;
+DROP TABLE IF EXISTS cnae;
+DROP TABLE IF EXISTS simples;
+DROP TABLE IF EXISTS socios;
+DROP TABLE IF EXISTS estabelecimento;
+DROP TABLE IF EXISTS empresas;
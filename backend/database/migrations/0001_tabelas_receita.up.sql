@@ -0,0 +1,80 @@
+-- Tabelas dos Dados Abertos CNPJ consultadas pelos repositories.
+-- IF NOT EXISTS permite adotar bancos criados antes das migrations por scripts manuais.
+
+CREATE TABLE IF NOT EXISTS empresas (
+    cnpj_basico                 TEXT NOT NULL,
+    razao_social                TEXT,
+    natureza_juridica           TEXT,
+    qualificacao_responsavel    TEXT,
+    porte_empresa               TEXT,
+    ente_federativo_responsavel TEXT,
+    capital_social              REAL
+);
+
+CREATE TABLE IF NOT EXISTS estabelecimento (
+    id                        SERIAL PRIMARY KEY,
+    cnpj                      TEXT NOT NULL,
+    cnpj_basico               TEXT NOT NULL,
+    cnpj_ordem                TEXT NOT NULL,
+    cnpj_dv                   TEXT NOT NULL,
+    matriz_filial             TEXT,
+    nome_fantasia             TEXT,
+    situacao_cadastral        TEXT,
+    data_situacao_cadastral   TEXT,
+    motivo_situacao_cadastral TEXT,
+    nome_cidade_exterior      TEXT,
+    pais                      TEXT,
+    data_inicio_atividades    TEXT,
+    cnae_fiscal               TEXT,
+    cnae_fiscal_secundaria    TEXT,
+    tipo_logradouro           TEXT,
+    logradouro                TEXT,
+    numero                    TEXT,
+    complemento               TEXT,
+    bairro                    TEXT,
+    cep                       TEXT,
+    uf                        TEXT,
+    municipio                 TEXT,
+    ddd1                      TEXT,
+    telefone1                 TEXT,
+    ddd2                      TEXT,
+    telefone2                 TEXT,
+    ddd_fax                   TEXT,
+    fax                       TEXT,
+    correio_eletronico        TEXT,
+    situacao_especial         TEXT,
+    data_situacao_especial    TEXT
+);
+
+CREATE TABLE IF NOT EXISTS socios (
+    cnpj                             TEXT,
+    cnpj_basico                      TEXT NOT NULL,
+    identificador_de_socio           TEXT,
+    nome_socio                       TEXT,
+    cnpj_cpf_socio                   TEXT,
+    qualificacao_socio               TEXT,
+    data_entrada_sociedade           TEXT,
+    pais                             TEXT,
+    representante_legal              TEXT,
+    nome_representante               TEXT,
+    qualificacao_representante_legal TEXT,
+    faixa_etaria                     TEXT
+);
+
+CREATE TABLE IF NOT EXISTS simples (
+    cnpj_basico           TEXT NOT NULL,
+    opcao_simples         TEXT,
+    data_opcao_simples    TEXT,
+    data_exclusao_simples TEXT,
+    opcao_mei             TEXT,
+    data_opcao_mei        TEXT,
+    data_exclusao_mei     TEXT
+);
+
+-- Tabelas de domínio (código -> descrição)
+CREATE TABLE IF NOT EXISTS cnae (codigo TEXT PRIMARY KEY, descricao TEXT NOT NULL);
+CREATE TABLE IF NOT EXISTS natureza_juridica (codigo TEXT PRIMARY KEY, descricao TEXT NOT NULL);
+CREATE TABLE IF NOT EXISTS qualificacao (codigo TEXT PRIMARY KEY, descricao TEXT NOT NULL);
+CREATE TABLE IF NOT EXISTS pais (codigo TEXT PRIMARY KEY, descricao TEXT NOT NULL);
+CREATE TABLE IF NOT EXISTS municipio (codigo TEXT PRIMARY KEY, descricao TEXT NOT NULL);
+CREATE TABLE IF NOT EXISTS motivo (codigo TEXT PRIMARY KEY, descricao TEXT NOT NULL);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS empresas_razao_social_trgm_idx;
+DROP INDEX IF EXISTS estabelecimento_municipio_trgm_idx;
+DROP INDEX IF EXISTS estabelecimento_nome_fantasia_trgm_idx;
+DROP INDEX IF EXISTS estabelecimento_situacao_cadastral_idx;
+DROP INDEX IF EXISTS estabelecimento_cnae_fiscal_idx;
+DROP INDEX IF EXISTS estabelecimento_uf_idx;
+DROP INDEX IF EXISTS estabelecimento_cnpj_basico_idx;
+DROP INDEX IF EXISTS estabelecimento_cnpj_idx;
+DROP INDEX IF EXISTS simples_cnpj_basico_idx;
+DROP INDEX IF EXISTS socios_cnpj_basico_idx;
+DROP INDEX IF EXISTS empresas_cnpj_basico_idx;
@@ -0,0 +1,19 @@
+-- Índices usados pelos repositories e por FindEstabelecimentosByFilters.
+-- O importador recria todos os índices da tabela viva nas tabelas _staging antes da troca.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS empresas_cnpj_basico_idx ON empresas (cnpj_basico);
+CREATE INDEX IF NOT EXISTS socios_cnpj_basico_idx ON socios (cnpj_basico);
+CREATE INDEX IF NOT EXISTS simples_cnpj_basico_idx ON simples (cnpj_basico);
+
+CREATE INDEX IF NOT EXISTS estabelecimento_cnpj_idx ON estabelecimento (cnpj);
+CREATE INDEX IF NOT EXISTS estabelecimento_cnpj_basico_idx ON estabelecimento (cnpj_basico);
+CREATE INDEX IF NOT EXISTS estabelecimento_uf_idx ON estabelecimento (uf);
+CREATE INDEX IF NOT EXISTS estabelecimento_cnae_fiscal_idx ON estabelecimento (cnae_fiscal);
+CREATE INDEX IF NOT EXISTS estabelecimento_situacao_cadastral_idx ON estabelecimento (situacao_cadastral);
+
+-- Trigramas para os filtros ILIKE '%...%'
+CREATE INDEX IF NOT EXISTS estabelecimento_nome_fantasia_trgm_idx ON estabelecimento USING gin (nome_fantasia gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS estabelecimento_municipio_trgm_idx ON estabelecimento USING gin (municipio gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS empresas_razao_social_trgm_idx ON empresas USING gin (razao_social gin_trgm_ops);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS quarentena;
+DROP TABLE IF EXISTS mudancas;
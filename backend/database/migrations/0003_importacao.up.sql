@@ -0,0 +1,27 @@
+-- Tabelas mantidas pelo importador (cmd/importer).
+
+-- Log de mudanças entre duas cargas, consultado pela query 'mudancas'.
+CREATE TABLE IF NOT EXISTS mudancas (
+    id             BIGSERIAL PRIMARY KEY,
+    tipo           TEXT NOT NULL,
+    cnpj           TEXT NOT NULL,
+    cnpj_basico    TEXT NOT NULL,
+    uf             TEXT,
+    cnae_fiscal    TEXT,
+    valor_anterior TEXT,
+    valor_novo     TEXT,
+    detectada_em   DATE NOT NULL DEFAULT CURRENT_DATE
+);
+CREATE INDEX IF NOT EXISTS mudancas_detectada_em_idx ON mudancas (detectada_em);
+CREATE INDEX IF NOT EXISTS mudancas_cnpj_idx ON mudancas (cnpj);
+
+-- Linhas rejeitadas pelos validadores durante a importação.
+CREATE TABLE IF NOT EXISTS quarentena (
+    id            BIGSERIAL PRIMARY KEY,
+    tabela        TEXT NOT NULL,
+    arquivo       TEXT NOT NULL,
+    linha         INTEGER NOT NULL,
+    motivo        TEXT NOT NULL,
+    conteudo      TEXT,
+    registrada_em TIMESTAMPTZ NOT NULL DEFAULT now()
+);
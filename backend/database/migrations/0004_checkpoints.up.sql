@@ -0,0 +1,14 @@
+-- Progresso da importação por arquivo, para retomar uma carga interrompida (ver importer/checkpoint.go).
+CREATE TABLE IF NOT EXISTS importacao_checkpoints (
+    tabela        TEXT NOT NULL,
+    arquivo       TEXT NOT NULL,
+    referencia    TEXT NOT NULL,
+    checksum      TEXT NOT NULL,
+    linhas        BIGINT NOT NULL DEFAULT 0,
+    aceitas       BIGINT NOT NULL DEFAULT 0,
+    corrigidas    BIGINT NOT NULL DEFAULT 0,
+    rejeitadas    BIGINT NOT NULL DEFAULT 0,
+    concluido     BOOLEAN NOT NULL DEFAULT false,
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (tabela, arquivo)
+);
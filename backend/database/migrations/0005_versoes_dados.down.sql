@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS versoes_dados_arquivos;
+DROP TABLE IF EXISTS versoes_dados_tabelas;
+DROP TABLE IF EXISTS versoes_dados;
+ALTER TABLE importacao_checkpoints DROP COLUMN IF EXISTS iniciado_em;
@@ -0,0 +1,34 @@
+-- Catálogo das cargas colocadas no ar pelo importador, consultado pela query 'dataVersion'.
+
+-- Início da carga de cada arquivo, para registrar quando uma carga retomada começou de fato.
+ALTER TABLE importacao_checkpoints ADD COLUMN IF NOT EXISTS iniciado_em TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- Uma carga de um dump mensal da Receita.
+CREATE TABLE IF NOT EXISTS versoes_dados (
+    id           BIGSERIAL PRIMARY KEY,
+    referencia   TEXT NOT NULL,
+    iniciada_em  TIMESTAMPTZ NOT NULL,
+    concluida_em TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Tabelas carregadas em cada versão. geracao indica qual versão de cada tabela está em
+-- <tabela> ('viva') e em <tabela>_old ('anterior'); o rollback do importador troca as duas.
+CREATE TABLE IF NOT EXISTS versoes_dados_tabelas (
+    versao_id  BIGINT NOT NULL REFERENCES versoes_dados (id) ON DELETE CASCADE,
+    tabela     TEXT NOT NULL,
+    linhas     BIGINT NOT NULL,
+    rejeitadas BIGINT NOT NULL,
+    geracao    TEXT,
+    PRIMARY KEY (versao_id, tabela)
+);
+CREATE INDEX IF NOT EXISTS versoes_dados_tabelas_geracao_idx ON versoes_dados_tabelas (tabela) WHERE geracao IS NOT NULL;
+
+-- Arquivos de origem de cada versão, com o checksum do zip.
+CREATE TABLE IF NOT EXISTS versoes_dados_arquivos (
+    versao_id BIGINT NOT NULL REFERENCES versoes_dados (id) ON DELETE CASCADE,
+    tabela    TEXT NOT NULL,
+    arquivo   TEXT NOT NULL,
+    checksum  TEXT NOT NULL,
+    linhas    BIGINT NOT NULL,
+    PRIMARY KEY (versao_id, tabela, arquivo)
+);
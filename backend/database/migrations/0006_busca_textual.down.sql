@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS estabelecimento_busca_idx;
+ALTER TABLE estabelecimento DROP COLUMN IF EXISTS busca;
+DO $$
+BEGIN
+    IF to_regclass('estabelecimento_old') IS NOT NULL THEN
+        ALTER TABLE estabelecimento_old DROP COLUMN IF EXISTS busca;
+    END IF;
+END $$;
+DROP FUNCTION IF EXISTS busca_documento(TEXT, TEXT, TEXT);
+DROP TEXT SEARCH CONFIGURATION IF EXISTS busca_pt;
@@ -0,0 +1,37 @@
+-- Busca textual da prospecção (argumento texto): razão social, nome fantasia e descrição do CNAE principal
+-- em estabelecimento.busca, sem acentos ("acai" encontra "AÇAÍ") e reduzidos aos radicais do português.
+-- O importador preenche a coluna a cada carga (ver fillBusca). A geração atual fica com a coluna vazia até
+-- a próxima carga ou até rodar o importador com -backfill: um UPDATE da tabela inteira aqui seguraria a
+-- subida da API, que aplica as migrations ao iniciar.
+
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- Configuração portuguese com unaccent antes do stemmer, usada tanto nos documentos quanto nas consultas.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'busca_pt') THEN
+        CREATE TEXT SEARCH CONFIGURATION busca_pt (COPY = portuguese);
+        ALTER TEXT SEARCH CONFIGURATION busca_pt
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+    END IF;
+END $$;
+
+-- Documento de busca de um estabelecimento. Razão social e nome fantasia pesam mais (A) que o CNAE (B)
+-- no ts_rank.
+CREATE OR REPLACE FUNCTION busca_documento(razao_social TEXT, nome_fantasia TEXT, cnae TEXT)
+RETURNS tsvector LANGUAGE sql STABLE PARALLEL SAFE AS $$
+    SELECT setweight(to_tsvector('busca_pt', coalesce(razao_social, '')), 'A')
+        || setweight(to_tsvector('busca_pt', coalesce(nome_fantasia, '')), 'A')
+        || setweight(to_tsvector('busca_pt', coalesce(cnae, '')), 'B')
+$$;
+
+ALTER TABLE estabelecimento ADD COLUMN IF NOT EXISTS busca tsvector;
+-- A geração anterior também ganha a coluna, para continuar consultável depois de um rollback.
+DO $$
+BEGIN
+    IF to_regclass('estabelecimento_old') IS NOT NULL THEN
+        ALTER TABLE estabelecimento_old ADD COLUMN IF NOT EXISTS busca tsvector;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS estabelecimento_busca_idx ON estabelecimento USING gin (busca);
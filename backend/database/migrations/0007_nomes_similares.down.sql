@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS estabelecimento_nome_fantasia_sem_acento_trgm_idx;
+DROP INDEX IF EXISTS empresas_razao_social_sem_acento_trgm_idx;
+DROP FUNCTION IF EXISTS sem_acento(TEXT);
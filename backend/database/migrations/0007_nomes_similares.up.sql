@@ -0,0 +1,14 @@
+-- Busca por nomes parecidos (empresasSimilares): similaridade de trigramas sobre a razão social e o nome
+-- fantasia, sem acentos ("padaria sao jose" encontra "PADARIA SÃO JOSÉ").
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent é STABLE e não pode ser usada em índices; com o dicionário explícito o resultado é fixo.
+CREATE OR REPLACE FUNCTION sem_acento(texto TEXT)
+RETURNS text LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT public.unaccent('public.unaccent'::regdictionary, texto)
+$$;
+
+CREATE INDEX IF NOT EXISTS empresas_razao_social_sem_acento_trgm_idx ON empresas USING gin (sem_acento(razao_social) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS estabelecimento_nome_fantasia_sem_acento_trgm_idx ON estabelecimento USING gin (sem_acento(nome_fantasia) gin_trgm_ops);
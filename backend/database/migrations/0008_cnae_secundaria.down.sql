@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS estabelecimento_cnae_secundaria_old;
+DROP TABLE IF EXISTS estabelecimento_cnae_secundaria;
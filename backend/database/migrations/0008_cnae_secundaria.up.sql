@@ -0,0 +1,31 @@
+-- CNAEs secundários normalizados: uma linha por código de estabelecimento.cnae_fiscal_secundaria
+-- ("6201501,6202300"), na ordem em que aparecem. Usada nos filtros por CNAE (comparação exata, sem o
+-- LIKE sobre a lista) e em ProspeccaoDetalhada.cnaeSecundaria.
+-- O importador recria a tabela a partir de cada carga de estabelecimentos (ver fillCNAESecundaria) e a
+-- troca junto com estabelecimento. Aqui ela é criada vazia: a geração atual é preenchida pela próxima carga
+-- ou pelo importador com -backfill, para que a migration não segure a subida da API.
+
+CREATE TABLE IF NOT EXISTS estabelecimento_cnae_secundaria (
+    cnpj  TEXT NOT NULL,
+    cnae  TEXT NOT NULL,
+    ordem INTEGER NOT NULL,
+    CONSTRAINT estabelecimento_cnae_secundaria_pkey PRIMARY KEY (cnpj, cnae)
+);
+
+-- Para os filtros: estabelecimentos que têm um código (a chave primária atende a busca por CNPJ).
+CREATE INDEX IF NOT EXISTS estabelecimento_cnae_secundaria_cnae_idx ON estabelecimento_cnae_secundaria (cnae, cnpj);
+
+-- A geração anterior de estabelecimento ganha a sua, para que o rollback troque as duas juntas.
+DO $$
+BEGIN
+    IF to_regclass('estabelecimento_old') IS NOT NULL
+        AND to_regclass('estabelecimento_cnae_secundaria_old') IS NULL THEN
+        CREATE TABLE estabelecimento_cnae_secundaria_old (
+            cnpj  TEXT NOT NULL,
+            cnae  TEXT NOT NULL,
+            ordem INTEGER NOT NULL,
+            CONSTRAINT estabelecimento_cnae_secundaria_pkey_old PRIMARY KEY (cnpj, cnae)
+        );
+        CREATE INDEX estabelecimento_cnae_secundaria_cnae_idx_old ON estabelecimento_cnae_secundaria_old (cnae, cnpj);
+    END IF;
+END $$;
@@ -0,0 +1,7 @@
+CREATE OR REPLACE FUNCTION sem_acento(texto TEXT)
+RETURNS text LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT public.unaccent('public.unaccent'::regdictionary, texto)
+$$;
+
+CREATE INDEX IF NOT EXISTS empresas_razao_social_sem_acento_trgm_idx ON empresas USING gin (sem_acento(razao_social) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS estabelecimento_nome_fantasia_sem_acento_trgm_idx ON estabelecimento USING gin (sem_acento(nome_fantasia) gin_trgm_ops);
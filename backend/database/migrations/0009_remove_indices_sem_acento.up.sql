@@ -0,0 +1,7 @@
+-- empresasSimilares e o filtro nomeSimilar comparam as colunas como estão, com os índices de trigramas da
+-- 0002_indices_prospeccao (o pg_trgm já não diferencia maiúsculas). Os índices sobre sem_acento() da
+-- 0007_nomes_similares duplicavam esses e não são mais usados.
+
+DROP INDEX IF EXISTS estabelecimento_nome_fantasia_sem_acento_trgm_idx;
+DROP INDEX IF EXISTS empresas_razao_social_sem_acento_trgm_idx;
+DROP FUNCTION IF EXISTS sem_acento(TEXT);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS mudancas_referencia_idx;
+ALTER TABLE mudancas DROP COLUMN IF EXISTS referencia;
@@ -0,0 +1,5 @@
+-- Mês de referência (AAAA-MM) da carga que detectou cada mudança. Com ele o importador apaga os eventos
+-- de uma carga desfeita pelo rollback, e os de uma carga refeita do mesmo mês antes de gravá-los de novo.
+-- As mudanças registradas antes desta migration ficam sem referência.
+ALTER TABLE mudancas ADD COLUMN IF NOT EXISTS referencia TEXT;
+CREATE INDEX IF NOT EXISTS mudancas_referencia_idx ON mudancas (referencia, tipo);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS quarentena_referencia_idx;
+ALTER TABLE quarentena DROP COLUMN IF EXISTS referencia;
@@ -0,0 +1,5 @@
+-- Mês de referência (AAAA-MM) do dump de cada linha rejeitada. Quando a carga de um mês recomeça do zero,
+-- o importador apaga a quarentena desse mês, para que as linhas não fiquem duplicadas.
+-- As linhas registradas antes desta migration ficam sem referência.
+ALTER TABLE quarentena ADD COLUMN IF NOT EXISTS referencia TEXT;
+CREATE INDEX IF NOT EXISTS quarentena_referencia_idx ON quarentena (referencia, tabela);
@@ -0,0 +1,11 @@
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['empresas', 'empresas_old', 'empresas_staging'] LOOP
+        IF to_regclass(t) IS NOT NULL THEN
+            EXECUTE format('ALTER TABLE %I ALTER COLUMN capital_social TYPE REAL', t);
+        END IF;
+    END LOOP;
+END
+$$;
@@ -0,0 +1,20 @@
+-- capital_social era REAL, que guarda só uns 7 dígitos significativos e arredonda capitais grandes
+-- (ex.: 123456789,12 vira 123456790). NUMERIC(18,2) guarda o valor exato, com centavos.
+-- O ALTER reescreve a tabela com um lock exclusivo: aplique numa janela de manutenção.
+-- Valores fora do intervalo de NUMERIC(18,2), NaN ou infinitos (vindos de dumps corrompidos) viram NULL.
+-- As gerações _old e _staging do importador, se existirem, são convertidas também, para que um rollback
+-- ou uma carga retomada não tragam a coluna REAL de volta.
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['empresas', 'empresas_old', 'empresas_staging'] LOOP
+        IF to_regclass(t) IS NOT NULL THEN
+            EXECUTE format(
+                'ALTER TABLE %I ALTER COLUMN capital_social TYPE NUMERIC(18,2)
+                 USING CASE WHEN abs(capital_social) < 1e16 THEN round(capital_social::NUMERIC, 2) END',
+                t);
+        END IF;
+    END LOOP;
+END
+$$;
@@ -3,6 +3,7 @@ package dataloaders
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/edufilhocruz/neurocloser/backend/models"
@@ -15,28 +16,55 @@ type Loaders struct {
 	EmpresaByCNPJBasico *dataloader.Loader
 	SociosByCNPJBasico  *dataloader.Loader
 	CNAEByCodigo        *dataloader.Loader
+
+	// CNAEs secundários de cada estabelecimento (estabelecimento_cnae_secundaria), pelo CNPJ completo
+	CNAESecundariosByCNPJ *dataloader.Loader
+
+	// Campos aninhados de Empresa: matriz e filiais, só a matriz e a opção pelo Simples/MEI
+	EstabelecimentosByCNPJBasico *dataloader.Loader
+	MatrizByCNPJBasico           *dataloader.Loader
+	SimplesByCNPJBasico          *dataloader.Loader
+
+	// Tabelas de domínio da Receita (código -> descrição)
+	NaturezaJuridicaByCodigo *dataloader.Loader
+	QualificacaoByCodigo     *dataloader.Loader
+	PaisByCodigo             *dataloader.Loader
+	MunicipioByCodigo        *dataloader.Loader
+	MotivoByCodigo           *dataloader.Loader
 }
 
-// NewLoaders cria e inicializa todos os Dataloaders.
-func NewLoaders(empresaRepo repositories.EmpresaRepository,
-	socioRepo repositories.SocioRepository,
-	cnaeRepo repositories.CNAERepository) *Loaders {
+// Repositories agrupa os repositórios usados pelos Dataloaders.
+type Repositories struct {
+	Empresa          repositories.EmpresaRepository
+	Estabelecimento  repositories.EstabelecimentoRepository
+	Socio            repositories.SocioRepository
+	Simples          repositories.SimplesRepository
+	CNAE             repositories.CNAERepository
+	NaturezaJuridica repositories.ReferenciaRepository
+	Qualificacao     repositories.ReferenciaRepository
+	Pais             repositories.ReferenciaRepository
+	Municipio        repositories.ReferenciaRepository
+	Motivo           repositories.ReferenciaRepository
+}
 
-	// Configurações comuns para os Dataloaders
-	loaderOptions := []dataloader.Option{
+// loaderOptions são as configurações comuns para os Dataloaders.
+// Cada Dataloader precisa do seu próprio cache: as chaves de loaders diferentes podem coincidir
+// (ex.: o mesmo CNPJ básico em EmpresaByCNPJBasico e SociosByCNPJBasico).
+func loaderOptions() []dataloader.Option {
+	return []dataloader.Option{
 		dataloader.WithCache(dataloader.NewCache()),
 		dataloader.WithBatchCapacity(100),
 		dataloader.WithWait(1 * time.Millisecond), // Pequeno delay para permitir batching
 	}
+}
 
+// NewLoaders cria e inicializa todos os Dataloaders.
+func NewLoaders(repos Repositories) *Loaders {
 	// Dataloader para Empresas por CNPJ Básico
 	empresaLoader := dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
-		cnpjBasicos := make([]string, len(keys))
-		for i, key := range keys {
-			cnpjBasicos[i] = key.String() // .String() para converter dataloader.Key para string
-		}
+		cnpjBasicos := cnpjBasicoKeys(keys)
 
-		empresas, err := empresaRepo.GetEmpresasByCNPJBasicos(cnpjBasicos)
+		empresas, err := repos.Empresa.GetEmpresasByCNPJBasicos(cnpjBasicos)
 		if err != nil {
 			return errorResults(err, len(keys))
 		}
@@ -47,8 +75,8 @@ func NewLoaders(empresaRepo repositories.EmpresaRepository,
 		}
 
 		results := make([]*dataloader.Result, len(keys))
-		for i, key := range keys {
-			if emp, ok := empresaMap[key.String()]; ok {
+		for i := range keys {
+			if emp, ok := empresaMap[cnpjBasicos[i]]; ok {
 				results[i] = &dataloader.Result{Data: emp}
 			} else {
 				// Se não encontrar, não é um erro, apenas um resultado nulo.
@@ -57,32 +85,29 @@ func NewLoaders(empresaRepo repositories.EmpresaRepository,
 			}
 		}
 		return results
-	}, loaderOptions...) // Aplica as opções
+	}, loaderOptions()...) // Aplica as opções
 
 	// Dataloader para Sócios por CNPJ Básico
 	// Retorna map[string][]*models.Socio para o Dataloader
 	socioLoader := dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
-		cnpjBasicos := make([]string, len(keys))
-		for i, key := range keys {
-			cnpjBasicos[i] = key.String()
-		}
+		cnpjBasicos := cnpjBasicoKeys(keys)
 
-		sociosMap, err := socioRepo.GetMultiplesSociosByCNPJBasicos(cnpjBasicos)
+		sociosMap, err := repos.Socio.GetMultiplesSociosByCNPJBasicos(cnpjBasicos)
 		if err != nil {
 			return errorResults(err, len(keys))
 		}
 
 		results := make([]*dataloader.Result, len(keys))
-		for i, key := range keys {
+		for i := range keys {
 			// Garante que sempre retorna uma slice, mesmo que vazia, se o CNPJ não tiver sócios
-			if s, ok := sociosMap[key.String()]; ok {
+			if s, ok := sociosMap[cnpjBasicos[i]]; ok {
 				results[i] = &dataloader.Result{Data: s}
 			} else {
 				results[i] = &dataloader.Result{Data: []*models.Socio{}}
 			}
 		}
 		return results
-	}, loaderOptions...)
+	}, loaderOptions()...)
 
 	// Dataloader para CNAEs por Código
 	cnaeLoader := dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
@@ -91,7 +116,7 @@ func NewLoaders(empresaRepo repositories.EmpresaRepository,
 			codigos[i] = key.String()
 		}
 
-		cnaes, err := cnaeRepo.GetCNAEsByCodigos(codigos)
+		cnaes, err := repos.CNAE.GetCNAEsByCodigos(codigos)
 		if err != nil {
 			return errorResults(err, len(keys))
 		}
@@ -111,15 +136,166 @@ func NewLoaders(empresaRepo repositories.EmpresaRepository,
 			}
 		}
 		return results
-	}, loaderOptions...)
+	}, loaderOptions()...)
+
+	// Dataloader para os CNAEs secundários por CNPJ completo
+	// Retorna []*models.CNAE, vazia se o estabelecimento não tiver CNAEs secundários
+	cnaeSecundarioLoader := dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		cnpjs := make([]string, len(keys))
+		for i, key := range keys {
+			cnpjs[i] = key.String()
+		}
+
+		cnaesMap, err := repos.CNAE.GetCNAEsSecundariosByCNPJs(cnpjs)
+		if err != nil {
+			return errorResults(err, len(keys))
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		for i := range keys {
+			if c, ok := cnaesMap[cnpjs[i]]; ok {
+				results[i] = &dataloader.Result{Data: c}
+			} else {
+				results[i] = &dataloader.Result{Data: []*models.CNAE{}}
+			}
+		}
+		return results
+	}, loaderOptions()...)
+
+	// Dataloader para a matriz e as filiais de cada CNPJ Básico
+	// Retorna []*models.Estabelecimento, vazia se o CNPJ não tiver estabelecimentos
+	estabelecimentosLoader := dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		cnpjBasicos := cnpjBasicoKeys(keys)
+
+		estabelecimentosMap, err := repos.Estabelecimento.GetEstabelecimentosByCNPJBasicos(cnpjBasicos)
+		if err != nil {
+			return errorResults(err, len(keys))
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		for i := range keys {
+			if e, ok := estabelecimentosMap[cnpjBasicos[i]]; ok {
+				results[i] = &dataloader.Result{Data: e}
+			} else {
+				results[i] = &dataloader.Result{Data: []*models.Estabelecimento{}}
+			}
+		}
+		return results
+	}, loaderOptions()...)
+
+	// Dataloader para o estabelecimento matriz de cada CNPJ Básico
+	matrizLoader := dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		cnpjBasicos := cnpjBasicoKeys(keys)
+
+		matrizes, err := repos.Estabelecimento.GetMatrizesByCNPJBasicos(cnpjBasicos)
+		if err != nil {
+			return errorResults(err, len(keys))
+		}
+
+		matrizMap := make(map[string]*models.Estabelecimento)
+		for _, m := range matrizes {
+			matrizMap[m.CNPJBasico] = m
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		for i := range keys {
+			if m, ok := matrizMap[cnpjBasicos[i]]; ok {
+				results[i] = &dataloader.Result{Data: m}
+			} else {
+				// Sem matriz na base (ex.: carga parcial): resultado nulo.
+				results[i] = &dataloader.Result{Data: nil}
+			}
+		}
+		return results
+	}, loaderOptions()...)
+
+	// Dataloader para a opção pelo Simples/MEI por CNPJ Básico
+	simplesLoader := dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		cnpjBasicos := cnpjBasicoKeys(keys)
+
+		simples, err := repos.Simples.GetSimplesByCNPJBasicos(cnpjBasicos)
+		if err != nil {
+			return errorResults(err, len(keys))
+		}
+
+		simplesMap := make(map[string]*models.Simples)
+		for _, s := range simples {
+			simplesMap[s.CNPJBasico] = s
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		for i := range keys {
+			if s, ok := simplesMap[cnpjBasicos[i]]; ok {
+				results[i] = &dataloader.Result{Data: s}
+			} else {
+				// Empresa que nunca optou pelo Simples: resultado nulo.
+				results[i] = &dataloader.Result{Data: nil}
+			}
+		}
+		return results
+	}, loaderOptions()...)
 
 	return &Loaders{
 		EmpresaByCNPJBasico: empresaLoader,
 		SociosByCNPJBasico:  socioLoader,
 		CNAEByCodigo:        cnaeLoader,
+
+		CNAESecundariosByCNPJ: cnaeSecundarioLoader,
+
+		EstabelecimentosByCNPJBasico: estabelecimentosLoader,
+		MatrizByCNPJBasico:           matrizLoader,
+		SimplesByCNPJBasico:          simplesLoader,
+
+		NaturezaJuridicaByCodigo: newReferenciaLoader(repos.NaturezaJuridica),
+		QualificacaoByCodigo:     newReferenciaLoader(repos.Qualificacao),
+		PaisByCodigo:             newReferenciaLoader(repos.Pais),
+		MunicipioByCodigo:        newReferenciaLoader(repos.Municipio),
+		MotivoByCodigo:           newReferenciaLoader(repos.Motivo),
 	}
 }
 
+// newReferenciaLoader cria um Dataloader de código -> descrição para uma tabela de domínio da Receita.
+func newReferenciaLoader(repo repositories.ReferenciaRepository) *dataloader.Loader {
+	return dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		codigos := make([]string, len(keys))
+		for i, key := range keys {
+			codigos[i] = key.String()
+		}
+
+		refs, err := repo.GetReferenciasByCodigos(codigos)
+		if err != nil {
+			return errorResults(err, len(keys))
+		}
+
+		refMap := make(map[string]*models.Referencia)
+		for _, ref := range refs {
+			refMap[ref.Codigo] = ref
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		for i, key := range keys {
+			if ref, ok := refMap[key.String()]; ok {
+				results[i] = &dataloader.Result{Data: ref}
+			} else {
+				// Código sem descrição na tabela de domínio: resultado nulo.
+				results[i] = &dataloader.Result{Data: nil}
+			}
+		}
+		return results
+	}, loaderOptions()...)
+}
+
+// cnpjBasicoKeys converte as chaves dos Dataloaders por CNPJ básico para o formato gravado no banco
+// (maiúsculas, sem espaços), já que a raiz do CNPJ alfanumérico pode chegar com letras minúsculas.
+// Os resultados são associados às chaves pela mesma posição.
+func cnpjBasicoKeys(keys dataloader.Keys) []string {
+	cnpjBasicos := make([]string, len(keys))
+	for i, key := range keys {
+		cnpjBasicos[i] = strings.ToUpper(strings.TrimSpace(key.String()))
+	}
+	return cnpjBasicos
+}
+
 // errorResults é uma função auxiliar para retornar erros em um formato compatível com dataloader.
 func errorResults(err error, count int) []*dataloader.Result {
 	results := make([]*dataloader.Result, count)
@@ -130,13 +306,10 @@ func errorResults(err error, count int) []*dataloader.Result {
 }
 
 // DataloaderMiddleware é um middleware HTTP que injeta os dataloaders no contexto da requisição.
-func DataloaderMiddleware(empresaRepo repositories.EmpresaRepository,
-	socioRepo repositories.SocioRepository,
-	cnaeRepo repositories.CNAERepository) func(http.Handler) http.Handler {
-
+func DataloaderMiddleware(repos Repositories) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			loaders := NewLoaders(empresaRepo, socioRepo, cnaeRepo)
+			loaders := NewLoaders(repos)
 			ctx := context.WithValue(r.Context(), loadersKey, loaders)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
+	"github.com/edufilhocruz/neurocloser/backend/repositories"
+)
+
+// Códigos da Receita de cada valor dos enums do schema.
+var (
+	situacaoCadastralCodes = map[model.SituacaoCadastral]string{
+		model.SituacaoCadastralNula:     "01",
+		model.SituacaoCadastralAtiva:    "02",
+		model.SituacaoCadastralSuspensa: "03",
+		model.SituacaoCadastralInapta:   "04",
+		model.SituacaoCadastralBaixada:  "08",
+	}
+	porteEmpresaCodes = map[model.PorteEmpresa]string{
+		model.PorteEmpresaMe:     "01",
+		model.PorteEmpresaEpp:    "03",
+		model.PorteEmpresaDemais: "05",
+	}
+	tipoEstabelecimentoCodes = map[model.TipoEstabelecimento]string{
+		model.TipoEstabelecimentoMatriz: "1",
+		model.TipoEstabelecimentoFilial: "2",
+	}
+	tipoSocioCodes = map[model.TipoSocio]string{
+		model.TipoSocioPj:          "1",
+		model.TipoSocioPf:          "2",
+		model.TipoSocioEstrangeiro: "3",
+	}
+)
+
+// modoFiltroCNAEModes liga os valores de ModoFiltroCNAE aos modos de filters["cnaesModo"].
+var modoFiltroCNAEModes = map[model.ModoFiltroCnae]string{
+	model.ModoFiltroCnaeQualquer:  repositories.CNAEsQualquer,
+	model.ModoFiltroCnaeTodos:     repositories.CNAEsTodos,
+	model.ModoFiltroCnaePrincipal: repositories.CNAEsPrincipal,
+}
+
+// enumFromCode devolve o valor do enum correspondente ao código da Receita, ou nil se o código não tiver
+// valor no enum (ex.: porte "00", não informado). Códigos de um dígito a menos que o esperado são aceitos
+// ("2" para a situação "02"), já que algumas exportações perdem o zero à esquerda; pelo mesmo motivo, os
+// filtros por situação e porte comparam as duas formas do código.
+func enumFromCode[E comparable](codes map[E]string, code string) *E {
+	for value, c := range codes {
+		if c == code || (len(code) == len(c)-1 && "0"+code == c) {
+			return &value
+		}
+	}
+	return nil
+}
+
+// sameEnum informa se o código bruto corresponde ao valor do enum, aceitando o código sem o zero à esquerda.
+func sameEnum[E comparable](codes map[E]string, code string, value E) bool {
+	v := enumFromCode(codes, code)
+	return v != nil && *v == value
+}
+
+// withEnumCodes junta aos códigos brutos de um filtro de lista os códigos dos valores do enum
+// (ex.: situacaoCadastralNotIn e situacaoNotIn), sem alterar a lista recebida.
+func withEnumCodes[E comparable](raw []string, values []E, codes map[E]string) []string {
+	result := append([]string{}, raw...)
+	for _, v := range values {
+		result = append(result, codes[v])
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
+)
+
+func TestEnumFromCode(t *testing.T) {
+	tests := []struct {
+		code string
+		want *model.SituacaoCadastral
+	}{
+		{"02", ptr(model.SituacaoCadastralAtiva)},
+		{"2", ptr(model.SituacaoCadastralAtiva)},
+		{"8", ptr(model.SituacaoCadastralBaixada)},
+		{"00", nil},
+		{"", nil},
+		{"002", nil},
+	}
+	for _, tt := range tests {
+		got := enumFromCode(situacaoCadastralCodes, tt.code)
+		if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+			t.Errorf("enumFromCode(%q) = %v, esperado %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestEnumFilterCodigoSemZero(t *testing.T) {
+	ativa := model.SituacaoCadastralAtiva
+	filters := map[string]interface{}{"situacaoCadastral": "2"}
+	if err := enumFilter(filters, "situacaoCadastral", "situacao", &ativa, situacaoCadastralCodes); err != nil {
+		t.Errorf("situacao ATIVA com situacaoCadastral \"2\": erro inesperado: %v", err)
+	}
+	if filters["situacaoCadastral"] != "02" {
+		t.Errorf("filters[situacaoCadastral] = %v, esperado 02", filters["situacaoCadastral"])
+	}
+
+	filters = map[string]interface{}{"situacaoCadastral": "8"}
+	if err := enumFilter(filters, "situacaoCadastral", "situacao", &ativa, situacaoCadastralCodes); err == nil {
+		t.Error("situacao ATIVA com situacaoCadastral \"8\": esperado erro")
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
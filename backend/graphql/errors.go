@@ -0,0 +1,31 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	gql "github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// validationCode é o extensions.code dos erros de entrada inválida, para o front-end distingui-los
+// de falhas do servidor e apontar o campo ao usuário.
+const validationCode = "VALIDACAO"
+
+// ErrorPresenter formata os erros das respostas GraphQL. Erros de validação (*models.ValidationError)
+// ganham extensions.code e extensions.campo; os demais seguem o formato padrão do gqlgen.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := gql.DefaultErrorPresenter(ctx, err)
+
+	var validationErr *models.ValidationError
+	if errors.As(err, &validationErr) {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		gqlErr.Extensions["code"] = validationCode
+		gqlErr.Extensions["campo"] = validationErr.Field
+	}
+	return gqlErr
+}
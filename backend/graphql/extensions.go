@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+	"github.com/edufilhocruz/neurocloser/backend/repositories"
+
+	gql "github.com/99designs/gqlgen/graphql"
+)
+
+// dataVersionTTL é por quanto tempo a versão dos dados fica em cache. Ela só muda quando o
+// importador coloca uma carga no ar, então não vale a pena consultar o banco a cada resposta.
+const dataVersionTTL = time.Minute
+
+// DataVersionExtension acrescenta a versão dos dados no ar em extensions.dataVersion de toda resposta
+// GraphQL, para que exportações e capturas de tela possam ser ligadas a um dump da Receita.
+type DataVersionExtension struct {
+	Repo repositories.VersaoDadosRepository
+
+	mu      sync.Mutex
+	cached  *models.VersaoDados
+	expires time.Time
+}
+
+var _ interface {
+	gql.HandlerExtension
+	gql.ResponseInterceptor
+} = &DataVersionExtension{}
+
+// ExtensionName identifica a extensão no servidor gqlgen.
+func (e *DataVersionExtension) ExtensionName() string {
+	return "DataVersion"
+}
+
+// Validate não tem nada a verificar no schema.
+func (e *DataVersionExtension) Validate(schema gql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse acrescenta a versão dos dados à resposta.
+func (e *DataVersionExtension) InterceptResponse(ctx context.Context, next gql.ResponseHandler) *gql.Response {
+	resp := next(ctx)
+	if resp == nil {
+		return nil
+	}
+
+	versao := e.current()
+	if versao == nil {
+		return resp
+	}
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]interface{}{}
+	}
+	resp.Extensions["dataVersion"] = map[string]interface{}{
+		"referencia":  versao.Referencia,
+		"concluidaEm": versao.ConcluidaEm,
+	}
+	return resp
+}
+
+// current retorna a versão em cache, consultando o banco quando o cache expira.
+// Se a consulta falhar, mantém a última versão conhecida e tenta de novo no próximo ciclo.
+func (e *DataVersionExtension) current() *models.VersaoDados {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if time.Now().Before(e.expires) {
+		return e.cached
+	}
+	versao, err := e.Repo.GetVersaoAtual()
+	if err != nil {
+		log.Printf("Erro ao consultar a versão dos dados: %v", err)
+	} else {
+		e.cached = versao
+	}
+	e.expires = time.Now().Add(dataVersionTTL)
+	return e.cached
+}
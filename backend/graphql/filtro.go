@@ -0,0 +1,116 @@
+package graphql
+
+import (
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
+	"github.com/edufilhocruz/neurocloser/backend/models"
+	"github.com/edufilhocruz/neurocloser/backend/repositories"
+)
+
+// campoFiltroFields liga os valores de CampoFiltro aos campos aceitos por repositories.Filter.
+var campoFiltroFields = map[model.CampoFiltro]string{
+	model.CampoFiltroCnpj:                  "cnpj",
+	model.CampoFiltroCnpjBasico:            "cnpjBasico",
+	model.CampoFiltroNomeFantasia:          "nomeFantasia",
+	model.CampoFiltroMatrizFilial:          "matrizFilial",
+	model.CampoFiltroSituacaoCadastral:     "situacaoCadastral",
+	model.CampoFiltroDataSituacaoCadastral: "dataSituacaoCadastral",
+	model.CampoFiltroDataInicioAtividades:  "dataInicioAtividades",
+	model.CampoFiltroCnaeFiscal:            "cnaeFiscal",
+	model.CampoFiltroCnaeFiscalSecundaria:  "cnaeFiscalSecundaria",
+	model.CampoFiltroUf:                    "uf",
+	model.CampoFiltroMunicipio:             "municipio",
+	model.CampoFiltroRazaoSocial:           "razaoSocial",
+	model.CampoFiltroNaturezaJuridica:      "naturezaJuridica",
+	model.CampoFiltroPorteEmpresa:          "porteEmpresa",
+	model.CampoFiltroCapitalSocial:         "capitalSocial",
+	model.CampoFiltroOpcaoSimples:          "opcaoSimples",
+	model.CampoFiltroDataOpcaoSimples:      "dataOpcaoSimples",
+	model.CampoFiltroDataExclusaoSimples:   "dataExclusaoSimples",
+	model.CampoFiltroOpcaoMei:              "opcaoMEI",
+	model.CampoFiltroDataOpcaoMei:          "dataOpcaoMEI",
+	model.CampoFiltroDataExclusaoMei:       "dataExclusaoMEI",
+}
+
+// filtroExpr converte a expressão de filtro do GraphQL em repositories.Filter. Aqui só se escolhe o operador
+// de cada predicado; a estrutura e os valores são validados ao compilar a consulta. Os limites de
+// profundidade e de predicados são conferidos durante a conversão, que para assim que um deles é excedido.
+func filtroExpr(e *model.FiltroExpr) (*repositories.Filter, error) {
+	var leaves int
+	return filtroNode(e, 1, &leaves)
+}
+
+// filtroNode converte um nó da expressão, que está no nível depth; leaves conta os predicados já vistos.
+func filtroNode(e *model.FiltroExpr, depth int, leaves *int) (*repositories.Filter, error) {
+	if depth > repositories.MaxFilterDepth {
+		return nil, repositories.FilterDepthError(depth)
+	}
+	if e.Campo != nil {
+		if *leaves++; *leaves > repositories.MaxFilterLeaves {
+			return nil, repositories.FilterLeavesError(*leaves)
+		}
+	}
+
+	f := &repositories.Filter{}
+	for _, child := range e.And {
+		c, err := filtroNode(child, depth+1, leaves)
+		if err != nil {
+			return nil, err
+		}
+		f.And = append(f.And, c)
+	}
+	for _, child := range e.Or {
+		c, err := filtroNode(child, depth+1, leaves)
+		if err != nil {
+			return nil, err
+		}
+		f.Or = append(f.Or, c)
+	}
+	// and: [] e or: [] continuam presentes (e inválidos, no caso do or) em vez de sumirem.
+	if e.And != nil && f.And == nil {
+		f.And = []*repositories.Filter{}
+	}
+	if e.Or != nil && f.Or == nil {
+		f.Or = []*repositories.Filter{}
+	}
+	if e.Not != nil {
+		c, err := filtroNode(e.Not, depth+1, leaves)
+		if err != nil {
+			return nil, err
+		}
+		f.Not = c
+	}
+
+	ops := 0
+	if e.Eq != nil {
+		f.Op, f.Value = repositories.OpEq, *e.Eq
+		ops++
+	}
+	if e.In != nil {
+		f.Op, f.Values = repositories.OpIn, e.In
+		ops++
+	}
+	if e.Range != nil {
+		f.Op, f.Min, f.Max = repositories.OpRange, e.Range.Min, e.Range.Max
+		ops++
+	}
+	if e.Prefix != nil {
+		f.Op, f.Value = repositories.OpPrefix, *e.Prefix
+		ops++
+	}
+	if e.Contains != nil {
+		f.Op, f.Value = repositories.OpContains, *e.Contains
+		ops++
+	}
+
+	if e.Campo == nil {
+		if ops > 0 {
+			return nil, &models.ValidationError{Field: "where", Reason: "operadores de predicado exigem campo"}
+		}
+		return f, nil
+	}
+	if ops != 1 {
+		return nil, &models.ValidationError{Field: "where", Value: e.Campo.String(), Reason: "o predicado deve ter exatamente um operador (eq, in, range, prefix ou contains)"}
+	}
+	f.Field = campoFiltroFields[*e.Campo]
+	return f, nil
+}
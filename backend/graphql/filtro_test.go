@@ -0,0 +1,93 @@
+package graphql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
+	"github.com/edufilhocruz/neurocloser/backend/models"
+	"github.com/edufilhocruz/neurocloser/backend/repositories"
+)
+
+func TestFiltroExpr(t *testing.T) {
+	uf, cnae := model.CampoFiltroUf, model.CampoFiltroCnaeFiscal
+	sp, prefixo := "SP", "47"
+	e := &model.FiltroExpr{And: []*model.FiltroExpr{
+		{Campo: &uf, Eq: &sp},
+		{Not: &model.FiltroExpr{Campo: &cnae, Prefix: &prefixo}},
+	}}
+	f, err := filtroExpr(e)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(f.And) != 2 || f.And[0].Field != "uf" || f.And[0].Op != repositories.OpEq || f.And[0].Value != "SP" {
+		t.Errorf("primeiro predicado convertido errado: %+v", f.And)
+	}
+	if not := f.And[1].Not; not == nil || not.Field != "cnaeFiscal" || not.Op != repositories.OpPrefix || not.Value != "47" {
+		t.Errorf("not convertido errado: %+v", f.And[1])
+	}
+}
+
+func TestFiltroExprErrors(t *testing.T) {
+	uf := model.CampoFiltroUf
+	sp := "SP"
+	tests := []struct {
+		name string
+		e    *model.FiltroExpr
+	}{
+		{"operador sem campo", &model.FiltroExpr{Eq: &sp}},
+		{"campo sem operador", &model.FiltroExpr{Campo: &uf}},
+		{"dois operadores", &model.FiltroExpr{Campo: &uf, Eq: &sp, Prefix: &sp}},
+	}
+	for _, tt := range tests {
+		_, err := filtroExpr(tt.e)
+		var verr *models.ValidationError
+		if !errors.As(err, &verr) || verr.Field != "where" {
+			t.Errorf("%s: erro = %v, esperado um ValidationError em where", tt.name, err)
+		}
+	}
+}
+
+// notChain monta uma expressão com depth níveis: depth-1 nots sobre um predicado.
+func notChain(depth int) *model.FiltroExpr {
+	uf := model.CampoFiltroUf
+	sp := "SP"
+	e := &model.FiltroExpr{Campo: &uf, Eq: &sp}
+	for i := 1; i < depth; i++ {
+		e = &model.FiltroExpr{Not: e}
+	}
+	return e
+}
+
+func TestFiltroExprLimits(t *testing.T) {
+	if _, err := filtroExpr(notChain(repositories.MaxFilterDepth)); err != nil {
+		t.Errorf("profundidade máxima: erro inesperado: %v", err)
+	}
+	if _, err := filtroExpr(notChain(repositories.MaxFilterDepth + 1)); err == nil {
+		t.Error("profundidade acima do máximo: esperado erro")
+	}
+	// Uma expressão muito mais funda que o limite é recusada sem ser percorrida até o fim; aqui o
+	// último nível nem tem predicado válido, e o erro ainda é o de profundidade.
+	deep := &model.FiltroExpr{}
+	for i := 0; i < 100000; i++ {
+		deep = &model.FiltroExpr{Not: deep}
+	}
+	_, err := filtroExpr(deep)
+	var verr *models.ValidationError
+	if !errors.As(err, &verr) || verr.Value != "7" {
+		t.Errorf("expressão profunda: erro = %v, esperado o limite de níveis no nível 7", err)
+	}
+
+	uf := model.CampoFiltroUf
+	sp := "SP"
+	and := &model.FiltroExpr{}
+	for i := 0; i <= repositories.MaxFilterLeaves; i++ {
+		and.And = append(and.And, &model.FiltroExpr{Campo: &uf, Eq: &sp})
+	}
+	if _, err := filtroExpr(&model.FiltroExpr{And: and.And[:repositories.MaxFilterLeaves]}); err != nil {
+		t.Errorf("predicados no máximo: erro inesperado: %v", err)
+	}
+	if _, err := filtroExpr(and); err == nil {
+		t.Error("predicados acima do máximo: esperado erro")
+	}
+}
@@ -3,17 +3,18 @@
 package generated
 
 import (
-	"backend/graphql/model"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
 	"github.com/edufilhocruz/neurocloser/backend/models"
 	gqlparser "github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
@@ -39,8 +40,14 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Empresa() EmpresaResolver
 	Estabelecimento() EstabelecimentoResolver
+	Mudanca() MudancaResolver
+	ProgressoImportacao() ProgressoImportacaoResolver
+	ProspeccaoConnection() ProspeccaoConnectionResolver
 	Query() QueryResolver
+	Socio() SocioResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -53,49 +60,101 @@ type ComplexityRoot struct {
 	}
 
 	Empresa struct {
-		CNPJBasico                func(childComplexity int) int
-		CapitalSocial             func(childComplexity int) int
-		EnteFederativoResponsavel func(childComplexity int) int
-		NaturezaJuridica          func(childComplexity int) int
-		PorteEmpresa              func(childComplexity int) int
-		QualificacaoResponsavel   func(childComplexity int) int
-		RazaoSocial               func(childComplexity int) int
+		CNPJBasico                 func(childComplexity int) int
+		CapitalSocial              func(childComplexity int) int
+		EnteFederativoResponsavel  func(childComplexity int) int
+		Estabelecimentos           func(childComplexity int) int
+		Matriz                     func(childComplexity int) int
+		NaturezaJuridica           func(childComplexity int) int
+		NaturezaJuridicaRef        func(childComplexity int) int
+		Porte                      func(childComplexity int) int
+		PorteEmpresa               func(childComplexity int) int
+		QualificacaoResponsavel    func(childComplexity int) int
+		QualificacaoResponsavelRef func(childComplexity int) int
+		RazaoSocial                func(childComplexity int) int
+		Simples                    func(childComplexity int) int
+		Socios                     func(childComplexity int) int
 	}
 
 	Estabelecimento struct {
-		Bairro                  func(childComplexity int) int
-		CEP                     func(childComplexity int) int
-		CNAEFiscal              func(childComplexity int) int
-		CNAEFiscalSecundaria    func(childComplexity int) int
-		CNPJ                    func(childComplexity int) int
-		CNPJBasico              func(childComplexity int) int
-		CNPJDV                  func(childComplexity int) int
-		CNPJOrdem               func(childComplexity int) int
-		CnpjFormatado           func(childComplexity int) int
-		Complemento             func(childComplexity int) int
-		CorreioEletronico       func(childComplexity int) int
-		DDD1                    func(childComplexity int) int
-		DDD2                    func(childComplexity int) int
-		DDDFax                  func(childComplexity int) int
-		DataInicioAtividades    func(childComplexity int) int
-		DataSituacaoCadastral   func(childComplexity int) int
-		DataSituacaoEspecial    func(childComplexity int) int
-		Fax                     func(childComplexity int) int
-		ID                      func(childComplexity int) int
-		Logradouro              func(childComplexity int) int
-		MatrizFilial            func(childComplexity int) int
-		MotivoSituacaoCadastral func(childComplexity int) int
-		Municipio               func(childComplexity int) int
-		NomeCidadeExterior      func(childComplexity int) int
-		NomeFantasia            func(childComplexity int) int
-		Numero                  func(childComplexity int) int
-		Pais                    func(childComplexity int) int
-		SituacaoCadastral       func(childComplexity int) int
-		SituacaoEspecial        func(childComplexity int) int
-		Telefone1               func(childComplexity int) int
-		Telefone2               func(childComplexity int) int
-		TipoLogradouro          func(childComplexity int) int
-		UF                      func(childComplexity int) int
+		Bairro                     func(childComplexity int) int
+		CEP                        func(childComplexity int) int
+		CNAEFiscal                 func(childComplexity int) int
+		CNAEFiscalSecundaria       func(childComplexity int) int
+		CNPJ                       func(childComplexity int) int
+		CNPJBasico                 func(childComplexity int) int
+		CNPJDV                     func(childComplexity int) int
+		CNPJFormatado              func(childComplexity int) int
+		CNPJOrdem                  func(childComplexity int) int
+		CnaePrincipal              func(childComplexity int) int
+		Complemento                func(childComplexity int) int
+		CorreioEletronico          func(childComplexity int) int
+		DDD1                       func(childComplexity int) int
+		DDD2                       func(childComplexity int) int
+		DDDFax                     func(childComplexity int) int
+		DataInicioAtividades       func(childComplexity int) int
+		DataSituacaoCadastral      func(childComplexity int) int
+		DataSituacaoEspecial       func(childComplexity int) int
+		Empresa                    func(childComplexity int) int
+		Fax                        func(childComplexity int) int
+		ID                         func(childComplexity int) int
+		Logradouro                 func(childComplexity int) int
+		MatrizFilial               func(childComplexity int) int
+		MotivoSituacaoCadastral    func(childComplexity int) int
+		MotivoSituacaoCadastralRef func(childComplexity int) int
+		Municipio                  func(childComplexity int) int
+		MunicipioRef               func(childComplexity int) int
+		NomeCidadeExterior         func(childComplexity int) int
+		NomeFantasia               func(childComplexity int) int
+		Numero                     func(childComplexity int) int
+		Pais                       func(childComplexity int) int
+		PaisRef                    func(childComplexity int) int
+		Situacao                   func(childComplexity int) int
+		SituacaoCadastral          func(childComplexity int) int
+		SituacaoEspecial           func(childComplexity int) int
+		Telefone1                  func(childComplexity int) int
+		Telefone2                  func(childComplexity int) int
+		Tipo                       func(childComplexity int) int
+		TipoLogradouro             func(childComplexity int) int
+		UF                         func(childComplexity int) int
+	}
+
+	Mudanca struct {
+		CNAEFiscal    func(childComplexity int) int
+		CNPJ          func(childComplexity int) int
+		CNPJBasico    func(childComplexity int) int
+		DetectadaEm   func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Referencia    func(childComplexity int) int
+		Tipo          func(childComplexity int) int
+		UF            func(childComplexity int) int
+		ValorAnterior func(childComplexity int) int
+		ValorNovo     func(childComplexity int) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
+	ProgressoImportacao struct {
+		Arquivo           func(childComplexity int) int
+		Em                func(childComplexity int) int
+		EtaSegundos       func(childComplexity int) int
+		Fase              func(childComplexity int) int
+		LinhasProcessadas func(childComplexity int) int
+		Mensagem          func(childComplexity int) int
+		Referencia        func(childComplexity int) int
+		Rejeitadas        func(childComplexity int) int
+		Tabela            func(childComplexity int) int
+	}
+
+	ProspeccaoConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
 	}
 
 	ProspeccaoDetalhada struct {
@@ -103,16 +162,43 @@ type ComplexityRoot struct {
 		CNAESecundaria  func(childComplexity int) int
 		Empresa         func(childComplexity int) int
 		Estabelecimento func(childComplexity int) int
+		Score           func(childComplexity int) int
+		Simples         func(childComplexity int) int
 		Socios          func(childComplexity int) int
 	}
 
+	ProspeccaoEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	ProspeccaoLoteItem struct {
+		Cnpj       func(childComplexity int) int
+		Encontrado func(childComplexity int) int
+		Entrada    func(childComplexity int) int
+		Erro       func(childComplexity int) int
+		Prospeccao func(childComplexity int) int
+	}
+
 	Query struct {
-		BuscarProspeccao   func(childComplexity int, filter *model.ProspeccaoFilter, limit *int, offset *int) int
-		CnaeByCodigo       func(childComplexity int, codigo string) int
-		Empresa            func(childComplexity int, cnpjBasico string) int
-		Empresas           func(childComplexity int, limit *int, offset *int) int
-		Estabelecimento    func(childComplexity int, id int) int
-		SociosByCnpjBasico func(childComplexity int, cnpjBasico string) int
+		BuscarProspeccao           func(childComplexity int, filter *model.ProspeccaoFilter, where *model.FiltroExpr, texto *string, limit *int, offset *int) int
+		BuscarProspeccaoConnection func(childComplexity int, filter *model.ProspeccaoFilter, where *model.FiltroExpr, texto *string, first *int, after *string) int
+		CnaeByCodigo               func(childComplexity int, codigo string) int
+		DataVersion                func(childComplexity int) int
+		Empresa                    func(childComplexity int, cnpjBasico string) int
+		Empresas                   func(childComplexity int, limit *int, offset *int) int
+		EmpresasSimilares          func(childComplexity int, nome string, uf *string, limiar *float64, limit *int) int
+		Estabelecimento            func(childComplexity int, id int) int
+		EstabelecimentoPorCnpj     func(childComplexity int, cnpj models.CNPJ) int
+		Mudancas                   func(childComplexity int, filter *model.MudancaFilter, limit *int, offset *int) int
+		ProspeccaoPorCnpjs         func(childComplexity int, cnpjs []string) int
+		Simples                    func(childComplexity int, cnpjBasico string) int
+		SociosByCnpjBasico         func(childComplexity int, cnpjBasico string) int
+	}
+
+	Referencia struct {
+		Codigo    func(childComplexity int) int
+		Descricao func(childComplexity int) int
 	}
 
 	Simples struct {
@@ -126,31 +212,116 @@ type ComplexityRoot struct {
 	}
 
 	Socio struct {
-		CNPJ                           func(childComplexity int) int
-		CNPJBasico                     func(childComplexity int) int
-		CNPJCPFSocio                   func(childComplexity int) int
-		DataEntradaSociedade           func(childComplexity int) int
-		FaixaEtaria                    func(childComplexity int) int
-		IdentificadorDeSocio           func(childComplexity int) int
-		NomeRepresentante              func(childComplexity int) int
-		NomeSocio                      func(childComplexity int) int
-		Pais                           func(childComplexity int) int
-		QualificacaoRepresentanteLegal func(childComplexity int) int
-		QualificacaoSocio              func(childComplexity int) int
-		RepresentanteLegal             func(childComplexity int) int
-	}
+		CNPJ                              func(childComplexity int) int
+		CNPJBasico                        func(childComplexity int) int
+		CNPJCPFSocio                      func(childComplexity int) int
+		DataEntradaSociedade              func(childComplexity int) int
+		Empresa                           func(childComplexity int) int
+		FaixaEtaria                       func(childComplexity int) int
+		IdentificadorDeSocio              func(childComplexity int) int
+		NomeRepresentante                 func(childComplexity int) int
+		NomeSocio                         func(childComplexity int) int
+		Pais                              func(childComplexity int) int
+		PaisRef                           func(childComplexity int) int
+		QualificacaoRepresentanteLegal    func(childComplexity int) int
+		QualificacaoRepresentanteLegalRef func(childComplexity int) int
+		QualificacaoSocio                 func(childComplexity int) int
+		QualificacaoSocioRef              func(childComplexity int) int
+		RepresentanteLegal                func(childComplexity int) int
+		Tipo                              func(childComplexity int) int
+	}
+
+	Subscription struct {
+		ProgressoImportacao func(childComplexity int) int
+	}
+
+	VersaoArquivo struct {
+		Arquivo  func(childComplexity int) int
+		Checksum func(childComplexity int) int
+		Linhas   func(childComplexity int) int
+		Tabela   func(childComplexity int) int
+	}
+
+	VersaoDados struct {
+		Arquivos    func(childComplexity int) int
+		ConcluidaEm func(childComplexity int) int
+		ID          func(childComplexity int) int
+		IniciadaEm  func(childComplexity int) int
+		Referencia  func(childComplexity int) int
+		Tabelas     func(childComplexity int) int
+	}
+
+	VersaoTabela struct {
+		CarregadaEm func(childComplexity int) int
+		Linhas      func(childComplexity int) int
+		Referencia  func(childComplexity int) int
+		Rejeitadas  func(childComplexity int) int
+		Tabela      func(childComplexity int) int
+	}
+}
+
+type EmpresaResolver interface {
+	Porte(ctx context.Context, obj *models.Empresa) (*model.PorteEmpresa, error)
+
+	NaturezaJuridicaRef(ctx context.Context, obj *models.Empresa) (*models.Referencia, error)
+	QualificacaoResponsavelRef(ctx context.Context, obj *models.Empresa) (*models.Referencia, error)
+	Estabelecimentos(ctx context.Context, obj *models.Empresa) ([]*models.Estabelecimento, error)
+	Matriz(ctx context.Context, obj *models.Empresa) (*models.Estabelecimento, error)
+	Socios(ctx context.Context, obj *models.Empresa) ([]*models.Socio, error)
+	Simples(ctx context.Context, obj *models.Empresa) (*models.Simples, error)
 }
-
 type EstabelecimentoResolver interface {
-	CnpjFormatado(ctx context.Context, obj *models.Estabelecimento) (string, error)
+	Empresa(ctx context.Context, obj *models.Estabelecimento) (*models.Empresa, error)
+
+	Tipo(ctx context.Context, obj *models.Estabelecimento) (*model.TipoEstabelecimento, error)
+
+	Situacao(ctx context.Context, obj *models.Estabelecimento) (*model.SituacaoCadastral, error)
+
+	MotivoSituacaoCadastralRef(ctx context.Context, obj *models.Estabelecimento) (*models.Referencia, error)
+
+	PaisRef(ctx context.Context, obj *models.Estabelecimento) (*models.Referencia, error)
+
+	CnaePrincipal(ctx context.Context, obj *models.Estabelecimento) (*models.CNAE, error)
+
+	MunicipioRef(ctx context.Context, obj *models.Estabelecimento) (*models.Referencia, error)
+}
+type MudancaResolver interface {
+	Tipo(ctx context.Context, obj *models.Mudanca) (model.TipoMudanca, error)
+}
+type ProgressoImportacaoResolver interface {
+	Fase(ctx context.Context, obj *models.ProgressoImportacao) (model.FaseImportacao, error)
+}
+type ProspeccaoConnectionResolver interface {
+	TotalCount(ctx context.Context, obj *models.ProspeccaoConnection) (*int, error)
 }
 type QueryResolver interface {
 	Empresas(ctx context.Context, limit *int, offset *int) ([]*models.Empresa, error)
 	Empresa(ctx context.Context, cnpjBasico string) (*models.Empresa, error)
 	Estabelecimento(ctx context.Context, id int) (*models.Estabelecimento, error)
+	EstabelecimentoPorCnpj(ctx context.Context, cnpj models.CNPJ) (*models.Estabelecimento, error)
 	SociosByCnpjBasico(ctx context.Context, cnpjBasico string) ([]*models.Socio, error)
 	CnaeByCodigo(ctx context.Context, codigo string) (*models.CNAE, error)
-	BuscarProspeccao(ctx context.Context, filter *model.ProspeccaoFilter, limit *int, offset *int) ([]*models.ProspeccaoDetalhada, error)
+	Simples(ctx context.Context, cnpjBasico string) (*models.Simples, error)
+	BuscarProspeccao(ctx context.Context, filter *model.ProspeccaoFilter, where *model.FiltroExpr, texto *string, limit *int, offset *int) ([]*models.ProspeccaoDetalhada, error)
+	BuscarProspeccaoConnection(ctx context.Context, filter *model.ProspeccaoFilter, where *model.FiltroExpr, texto *string, first *int, after *string) (*models.ProspeccaoConnection, error)
+	ProspeccaoPorCnpjs(ctx context.Context, cnpjs []string) ([]*model.ProspeccaoLoteItem, error)
+	EmpresasSimilares(ctx context.Context, nome string, uf *string, limiar *float64, limit *int) ([]*models.ProspeccaoDetalhada, error)
+	Mudancas(ctx context.Context, filter *model.MudancaFilter, limit *int, offset *int) ([]*models.Mudanca, error)
+	DataVersion(ctx context.Context) (*models.VersaoDados, error)
+}
+type SocioResolver interface {
+	Tipo(ctx context.Context, obj *models.Socio) (*model.TipoSocio, error)
+
+	QualificacaoSocioRef(ctx context.Context, obj *models.Socio) (*models.Referencia, error)
+
+	PaisRef(ctx context.Context, obj *models.Socio) (*models.Referencia, error)
+
+	QualificacaoRepresentanteLegalRef(ctx context.Context, obj *models.Socio) (*models.Referencia, error)
+
+	Empresa(ctx context.Context, obj *models.Socio) (*models.Empresa, error)
+}
+type SubscriptionResolver interface {
+	ProgressoImportacao(ctx context.Context) (<-chan *models.ProgressoImportacao, error)
 }
 
 type executableSchema struct {
@@ -207,6 +378,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Empresa.EnteFederativoResponsavel(childComplexity), true
 
+	case "Empresa.estabelecimentos":
+		if e.complexity.Empresa.Estabelecimentos == nil {
+			break
+		}
+
+		return e.complexity.Empresa.Estabelecimentos(childComplexity), true
+
+	case "Empresa.matriz":
+		if e.complexity.Empresa.Matriz == nil {
+			break
+		}
+
+		return e.complexity.Empresa.Matriz(childComplexity), true
+
 	case "Empresa.naturezaJuridica":
 		if e.complexity.Empresa.NaturezaJuridica == nil {
 			break
@@ -214,6 +399,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Empresa.NaturezaJuridica(childComplexity), true
 
+	case "Empresa.naturezaJuridicaRef":
+		if e.complexity.Empresa.NaturezaJuridicaRef == nil {
+			break
+		}
+
+		return e.complexity.Empresa.NaturezaJuridicaRef(childComplexity), true
+
+	case "Empresa.porte":
+		if e.complexity.Empresa.Porte == nil {
+			break
+		}
+
+		return e.complexity.Empresa.Porte(childComplexity), true
+
 	case "Empresa.porteEmpresa":
 		if e.complexity.Empresa.PorteEmpresa == nil {
 			break
@@ -228,6 +427,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Empresa.QualificacaoResponsavel(childComplexity), true
 
+	case "Empresa.qualificacaoResponsavelRef":
+		if e.complexity.Empresa.QualificacaoResponsavelRef == nil {
+			break
+		}
+
+		return e.complexity.Empresa.QualificacaoResponsavelRef(childComplexity), true
+
 	case "Empresa.razaoSocial":
 		if e.complexity.Empresa.RazaoSocial == nil {
 			break
@@ -235,6 +441,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Empresa.RazaoSocial(childComplexity), true
 
+	case "Empresa.simples":
+		if e.complexity.Empresa.Simples == nil {
+			break
+		}
+
+		return e.complexity.Empresa.Simples(childComplexity), true
+
+	case "Empresa.socios":
+		if e.complexity.Empresa.Socios == nil {
+			break
+		}
+
+		return e.complexity.Empresa.Socios(childComplexity), true
+
 	case "Estabelecimento.bairro":
 		if e.complexity.Estabelecimento.Bairro == nil {
 			break
@@ -284,6 +504,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.CNPJDV(childComplexity), true
 
+	case "Estabelecimento.cnpjFormatado":
+		if e.complexity.Estabelecimento.CNPJFormatado == nil {
+			break
+		}
+
+		return e.complexity.Estabelecimento.CNPJFormatado(childComplexity), true
+
 	case "Estabelecimento.cnpjOrdem":
 		if e.complexity.Estabelecimento.CNPJOrdem == nil {
 			break
@@ -291,12 +518,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.CNPJOrdem(childComplexity), true
 
-	case "Estabelecimento.cnpjFormatado":
-		if e.complexity.Estabelecimento.CnpjFormatado == nil {
+	case "Estabelecimento.cnaePrincipal":
+		if e.complexity.Estabelecimento.CnaePrincipal == nil {
 			break
 		}
 
-		return e.complexity.Estabelecimento.CnpjFormatado(childComplexity), true
+		return e.complexity.Estabelecimento.CnaePrincipal(childComplexity), true
 
 	case "Estabelecimento.complemento":
 		if e.complexity.Estabelecimento.Complemento == nil {
@@ -354,6 +581,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.DataSituacaoEspecial(childComplexity), true
 
+	case "Estabelecimento.empresa":
+		if e.complexity.Estabelecimento.Empresa == nil {
+			break
+		}
+
+		return e.complexity.Estabelecimento.Empresa(childComplexity), true
+
 	case "Estabelecimento.fax":
 		if e.complexity.Estabelecimento.Fax == nil {
 			break
@@ -389,6 +623,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.MotivoSituacaoCadastral(childComplexity), true
 
+	case "Estabelecimento.motivoSituacaoCadastralRef":
+		if e.complexity.Estabelecimento.MotivoSituacaoCadastralRef == nil {
+			break
+		}
+
+		return e.complexity.Estabelecimento.MotivoSituacaoCadastralRef(childComplexity), true
+
 	case "Estabelecimento.municipio":
 		if e.complexity.Estabelecimento.Municipio == nil {
 			break
@@ -396,6 +637,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.Municipio(childComplexity), true
 
+	case "Estabelecimento.municipioRef":
+		if e.complexity.Estabelecimento.MunicipioRef == nil {
+			break
+		}
+
+		return e.complexity.Estabelecimento.MunicipioRef(childComplexity), true
+
 	case "Estabelecimento.nomeCidadeExterior":
 		if e.complexity.Estabelecimento.NomeCidadeExterior == nil {
 			break
@@ -424,6 +672,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.Pais(childComplexity), true
 
+	case "Estabelecimento.paisRef":
+		if e.complexity.Estabelecimento.PaisRef == nil {
+			break
+		}
+
+		return e.complexity.Estabelecimento.PaisRef(childComplexity), true
+
+	case "Estabelecimento.situacao":
+		if e.complexity.Estabelecimento.Situacao == nil {
+			break
+		}
+
+		return e.complexity.Estabelecimento.Situacao(childComplexity), true
+
 	case "Estabelecimento.situacaoCadastral":
 		if e.complexity.Estabelecimento.SituacaoCadastral == nil {
 			break
@@ -452,6 +714,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.Telefone2(childComplexity), true
 
+	case "Estabelecimento.tipo":
+		if e.complexity.Estabelecimento.Tipo == nil {
+			break
+		}
+
+		return e.complexity.Estabelecimento.Tipo(childComplexity), true
+
 	case "Estabelecimento.tipoLogradouro":
 		if e.complexity.Estabelecimento.TipoLogradouro == nil {
 			break
@@ -466,2661 +735,2130 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Estabelecimento.UF(childComplexity), true
 
-	case "ProspeccaoDetalhada.cnaeFiscal":
-		if e.complexity.ProspeccaoDetalhada.CNAEFiscal == nil {
+	case "Mudanca.cnaeFiscal":
+		if e.complexity.Mudanca.CNAEFiscal == nil {
 			break
 		}
 
-		return e.complexity.ProspeccaoDetalhada.CNAEFiscal(childComplexity), true
+		return e.complexity.Mudanca.CNAEFiscal(childComplexity), true
 
-	case "ProspeccaoDetalhada.cnaeSecundaria":
-		if e.complexity.ProspeccaoDetalhada.CNAESecundaria == nil {
+	case "Mudanca.cnpj":
+		if e.complexity.Mudanca.CNPJ == nil {
 			break
 		}
 
-		return e.complexity.ProspeccaoDetalhada.CNAESecundaria(childComplexity), true
+		return e.complexity.Mudanca.CNPJ(childComplexity), true
 
-	case "ProspeccaoDetalhada.empresa":
-		if e.complexity.ProspeccaoDetalhada.Empresa == nil {
+	case "Mudanca.cnpjBasico":
+		if e.complexity.Mudanca.CNPJBasico == nil {
 			break
 		}
 
-		return e.complexity.ProspeccaoDetalhada.Empresa(childComplexity), true
+		return e.complexity.Mudanca.CNPJBasico(childComplexity), true
 
-	case "ProspeccaoDetalhada.estabelecimento":
-		if e.complexity.ProspeccaoDetalhada.Estabelecimento == nil {
+	case "Mudanca.detectadaEm":
+		if e.complexity.Mudanca.DetectadaEm == nil {
 			break
 		}
 
-		return e.complexity.ProspeccaoDetalhada.Estabelecimento(childComplexity), true
+		return e.complexity.Mudanca.DetectadaEm(childComplexity), true
 
-	case "ProspeccaoDetalhada.socios":
-		if e.complexity.ProspeccaoDetalhada.Socios == nil {
+	case "Mudanca.id":
+		if e.complexity.Mudanca.ID == nil {
 			break
 		}
 
-		return e.complexity.ProspeccaoDetalhada.Socios(childComplexity), true
+		return e.complexity.Mudanca.ID(childComplexity), true
 
-	case "Query.buscarProspeccao":
-		if e.complexity.Query.BuscarProspeccao == nil {
+	case "Mudanca.referencia":
+		if e.complexity.Mudanca.Referencia == nil {
 			break
 		}
 
-		args, err := ec.field_Query_buscarProspeccao_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Mudanca.Referencia(childComplexity), true
+
+	case "Mudanca.tipo":
+		if e.complexity.Mudanca.Tipo == nil {
+			break
 		}
 
-		return e.complexity.Query.BuscarProspeccao(childComplexity, args["filter"].(*model.ProspeccaoFilter), args["limit"].(*int), args["offset"].(*int)), true
+		return e.complexity.Mudanca.Tipo(childComplexity), true
 
-	case "Query.cnaeByCodigo":
-		if e.complexity.Query.CnaeByCodigo == nil {
+	case "Mudanca.uf":
+		if e.complexity.Mudanca.UF == nil {
 			break
 		}
 
-		args, err := ec.field_Query_cnaeByCodigo_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Mudanca.UF(childComplexity), true
+
+	case "Mudanca.valorAnterior":
+		if e.complexity.Mudanca.ValorAnterior == nil {
+			break
 		}
 
-		return e.complexity.Query.CnaeByCodigo(childComplexity, args["codigo"].(string)), true
+		return e.complexity.Mudanca.ValorAnterior(childComplexity), true
 
-	case "Query.empresa":
-		if e.complexity.Query.Empresa == nil {
+	case "Mudanca.valorNovo":
+		if e.complexity.Mudanca.ValorNovo == nil {
 			break
 		}
 
-		args, err := ec.field_Query_empresa_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Mudanca.ValorNovo(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
 		}
 
-		return e.complexity.Query.Empresa(childComplexity, args["cnpjBasico"].(string)), true
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
 
-	case "Query.empresas":
-		if e.complexity.Query.Empresas == nil {
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
 			break
 		}
 
-		args, err := ec.field_Query_empresas_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
 		}
 
-		return e.complexity.Query.Empresas(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
 
-	case "Query.estabelecimento":
-		if e.complexity.Query.Estabelecimento == nil {
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
 			break
 		}
 
-		args, err := ec.field_Query_estabelecimento_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "ProgressoImportacao.arquivo":
+		if e.complexity.ProgressoImportacao.Arquivo == nil {
+			break
 		}
 
-		return e.complexity.Query.Estabelecimento(childComplexity, args["id"].(int)), true
+		return e.complexity.ProgressoImportacao.Arquivo(childComplexity), true
 
-	case "Query.sociosByCnpjBasico":
-		if e.complexity.Query.SociosByCnpjBasico == nil {
+	case "ProgressoImportacao.em":
+		if e.complexity.ProgressoImportacao.Em == nil {
 			break
 		}
 
-		args, err := ec.field_Query_sociosByCnpjBasico_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ProgressoImportacao.Em(childComplexity), true
+
+	case "ProgressoImportacao.etaSegundos":
+		if e.complexity.ProgressoImportacao.EtaSegundos == nil {
+			break
 		}
 
-		return e.complexity.Query.SociosByCnpjBasico(childComplexity, args["cnpjBasico"].(string)), true
+		return e.complexity.ProgressoImportacao.EtaSegundos(childComplexity), true
 
-	case "Simples.cnpjBasico":
-		if e.complexity.Simples.CNPJBasico == nil {
+	case "ProgressoImportacao.fase":
+		if e.complexity.ProgressoImportacao.Fase == nil {
 			break
 		}
 
-		return e.complexity.Simples.CNPJBasico(childComplexity), true
+		return e.complexity.ProgressoImportacao.Fase(childComplexity), true
 
-	case "Simples.dataExclusaoMEI":
-		if e.complexity.Simples.DataExclusaoMEI == nil {
+	case "ProgressoImportacao.linhasProcessadas":
+		if e.complexity.ProgressoImportacao.LinhasProcessadas == nil {
 			break
 		}
 
-		return e.complexity.Simples.DataExclusaoMEI(childComplexity), true
+		return e.complexity.ProgressoImportacao.LinhasProcessadas(childComplexity), true
 
-	case "Simples.dataExclusaoSimples":
-		if e.complexity.Simples.DataExclusaoSimples == nil {
+	case "ProgressoImportacao.mensagem":
+		if e.complexity.ProgressoImportacao.Mensagem == nil {
 			break
 		}
 
-		return e.complexity.Simples.DataExclusaoSimples(childComplexity), true
+		return e.complexity.ProgressoImportacao.Mensagem(childComplexity), true
 
-	case "Simples.dataOpcaoMEI":
-		if e.complexity.Simples.DataOpcaoMEI == nil {
+	case "ProgressoImportacao.referencia":
+		if e.complexity.ProgressoImportacao.Referencia == nil {
 			break
 		}
 
-		return e.complexity.Simples.DataOpcaoMEI(childComplexity), true
+		return e.complexity.ProgressoImportacao.Referencia(childComplexity), true
 
-	case "Simples.dataOpcaoSimples":
-		if e.complexity.Simples.DataOpcaoSimples == nil {
+	case "ProgressoImportacao.rejeitadas":
+		if e.complexity.ProgressoImportacao.Rejeitadas == nil {
 			break
 		}
 
-		return e.complexity.Simples.DataOpcaoSimples(childComplexity), true
+		return e.complexity.ProgressoImportacao.Rejeitadas(childComplexity), true
 
-	case "Simples.opcaoMEI":
-		if e.complexity.Simples.OpcaoMEI == nil {
+	case "ProgressoImportacao.tabela":
+		if e.complexity.ProgressoImportacao.Tabela == nil {
 			break
 		}
 
-		return e.complexity.Simples.OpcaoMEI(childComplexity), true
+		return e.complexity.ProgressoImportacao.Tabela(childComplexity), true
 
-	case "Simples.opcaoSimples":
-		if e.complexity.Simples.OpcaoSimples == nil {
+	case "ProspeccaoConnection.edges":
+		if e.complexity.ProspeccaoConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.Simples.OpcaoSimples(childComplexity), true
+		return e.complexity.ProspeccaoConnection.Edges(childComplexity), true
 
-	case "Socio.cnpj":
-		if e.complexity.Socio.CNPJ == nil {
+	case "ProspeccaoConnection.pageInfo":
+		if e.complexity.ProspeccaoConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.Socio.CNPJ(childComplexity), true
+		return e.complexity.ProspeccaoConnection.PageInfo(childComplexity), true
 
-	case "Socio.cnpjBasico":
-		if e.complexity.Socio.CNPJBasico == nil {
+	case "ProspeccaoConnection.totalCount":
+		if e.complexity.ProspeccaoConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.Socio.CNPJBasico(childComplexity), true
+		return e.complexity.ProspeccaoConnection.TotalCount(childComplexity), true
 
-	case "Socio.cnpjCpfSocio":
-		if e.complexity.Socio.CNPJCPFSocio == nil {
+	case "ProspeccaoDetalhada.cnaeFiscal":
+		if e.complexity.ProspeccaoDetalhada.CNAEFiscal == nil {
 			break
 		}
 
-		return e.complexity.Socio.CNPJCPFSocio(childComplexity), true
+		return e.complexity.ProspeccaoDetalhada.CNAEFiscal(childComplexity), true
 
-	case "Socio.dataEntradaSociedade":
-		if e.complexity.Socio.DataEntradaSociedade == nil {
+	case "ProspeccaoDetalhada.cnaeSecundaria":
+		if e.complexity.ProspeccaoDetalhada.CNAESecundaria == nil {
 			break
 		}
 
-		return e.complexity.Socio.DataEntradaSociedade(childComplexity), true
+		return e.complexity.ProspeccaoDetalhada.CNAESecundaria(childComplexity), true
 
-	case "Socio.faixaEtaria":
-		if e.complexity.Socio.FaixaEtaria == nil {
+	case "ProspeccaoDetalhada.empresa":
+		if e.complexity.ProspeccaoDetalhada.Empresa == nil {
 			break
 		}
 
-		return e.complexity.Socio.FaixaEtaria(childComplexity), true
+		return e.complexity.ProspeccaoDetalhada.Empresa(childComplexity), true
 
-	case "Socio.identificadorDeSocio":
-		if e.complexity.Socio.IdentificadorDeSocio == nil {
+	case "ProspeccaoDetalhada.estabelecimento":
+		if e.complexity.ProspeccaoDetalhada.Estabelecimento == nil {
 			break
 		}
 
-		return e.complexity.Socio.IdentificadorDeSocio(childComplexity), true
+		return e.complexity.ProspeccaoDetalhada.Estabelecimento(childComplexity), true
 
-	case "Socio.nomeRepresentante":
-		if e.complexity.Socio.NomeRepresentante == nil {
+	case "ProspeccaoDetalhada.score":
+		if e.complexity.ProspeccaoDetalhada.Score == nil {
 			break
 		}
 
-		return e.complexity.Socio.NomeRepresentante(childComplexity), true
+		return e.complexity.ProspeccaoDetalhada.Score(childComplexity), true
 
-	case "Socio.nomeSocio":
-		if e.complexity.Socio.NomeSocio == nil {
+	case "ProspeccaoDetalhada.simples":
+		if e.complexity.ProspeccaoDetalhada.Simples == nil {
 			break
 		}
 
-		return e.complexity.Socio.NomeSocio(childComplexity), true
+		return e.complexity.ProspeccaoDetalhada.Simples(childComplexity), true
 
-	case "Socio.pais":
-		if e.complexity.Socio.Pais == nil {
+	case "ProspeccaoDetalhada.socios":
+		if e.complexity.ProspeccaoDetalhada.Socios == nil {
 			break
 		}
 
-		return e.complexity.Socio.Pais(childComplexity), true
+		return e.complexity.ProspeccaoDetalhada.Socios(childComplexity), true
 
-	case "Socio.qualificacaoRepresentanteLegal":
-		if e.complexity.Socio.QualificacaoRepresentanteLegal == nil {
+	case "ProspeccaoEdge.cursor":
+		if e.complexity.ProspeccaoEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.Socio.QualificacaoRepresentanteLegal(childComplexity), true
+		return e.complexity.ProspeccaoEdge.Cursor(childComplexity), true
 
-	case "Socio.qualificacaoSocio":
-		if e.complexity.Socio.QualificacaoSocio == nil {
+	case "ProspeccaoEdge.node":
+		if e.complexity.ProspeccaoEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.Socio.QualificacaoSocio(childComplexity), true
+		return e.complexity.ProspeccaoEdge.Node(childComplexity), true
 
-	case "Socio.representanteLegal":
-		if e.complexity.Socio.RepresentanteLegal == nil {
+	case "ProspeccaoLoteItem.cnpj":
+		if e.complexity.ProspeccaoLoteItem.Cnpj == nil {
 			break
 		}
 
-		return e.complexity.Socio.RepresentanteLegal(childComplexity), true
-
-	}
-	return 0, false
-}
+		return e.complexity.ProspeccaoLoteItem.Cnpj(childComplexity), true
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputProspeccaoFilter,
-	)
-	first := true
+	case "ProspeccaoLoteItem.encontrado":
+		if e.complexity.ProspeccaoLoteItem.Encontrado == nil {
+			break
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+		return e.complexity.ProspeccaoLoteItem.Encontrado(childComplexity), true
 
-			return &response
+	case "ProspeccaoLoteItem.entrada":
+		if e.complexity.ProspeccaoLoteItem.Entrada == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
-
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+		return e.complexity.ProspeccaoLoteItem.Entrada(childComplexity), true
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+	case "ProspeccaoLoteItem.erro":
+		if e.complexity.ProspeccaoLoteItem.Erro == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		return e.complexity.ProspeccaoLoteItem.Erro(childComplexity), true
+
+	case "ProspeccaoLoteItem.prospeccao":
+		if e.complexity.ProspeccaoLoteItem.Prospeccao == nil {
+			break
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.ProspeccaoLoteItem.Prospeccao(childComplexity), true
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+	case "Query.buscarProspeccao":
+		if e.complexity.Query.BuscarProspeccao == nil {
+			break
+		}
 
-var sources = []*ast.Source{
-	{Name: "../schema.graphqls", Input: `# Tipos de dados (equivalente aos nossos Go Models)
+		args, err := ec.field_Query_buscarProspeccao_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type Empresa {
-  cnpjBasico: String!
-  razaoSocial: String!
-  naturezaJuridica: String!
-  qualificacaoResponsavel: String!
-  porteEmpresa: String!
-  enteFederativoResponsavel: String!
-  capitalSocial: Float!
-}
+		return e.complexity.Query.BuscarProspeccao(childComplexity, args["filter"].(*model.ProspeccaoFilter), args["where"].(*model.FiltroExpr), args["texto"].(*string), args["limit"].(*int), args["offset"].(*int)), true
 
-type Estabelecimento {
-  id: Int!
-  cnpj: String! # CNPJ bruto (sem formatação)
-  cnpjFormatado: String! # NOVO: CNPJ formatado (XX.XXX.XXX/XXXX-XX)
-  cnpjBasico: String!
-  cnpjOrdem: String!
-  cnpjDv: String!
-  matrizFilial: String!
-  nomeFantasia: String
-  situacaoCadastral: String! # Situação Cadastral
-  dataSituacaoCadastral: String!
-  motivoSituacaoCadastral: String
-  nomeCidadeExterior: String
-  pais: String
-  dataInicioAtividades: String!
-  cnaeFiscal: String! # Código CNAE Fiscal Principal (será um código, precisamos buscar a descrição)
-  cnaeFiscalSecundaria: String # Códigos CNAE Fiscal Secundário (serão códigos)
-  tipoLogradouro: String!
-  logradouro: String!
-  numero: String!
-  complemento: String
-  bairro: String
-  cep: String!
-  uf: String! # Estado
-  municipio: String!
-  ddd1: String
-  telefone1: String
-  ddd2: String
-  telefone2: String
-  dddFax: String
-  fax: String
-  correioEletronico: String
-  situacaoEspecial: String
-  dataSituacaoEspecial: String
-}
+	case "Query.buscarProspeccaoConnection":
+		if e.complexity.Query.BuscarProspeccaoConnection == nil {
+			break
+		}
 
-type Simples {
-  cnpjBasico: String!
-  opcaoSimples: String!
-  dataOpcaoSimples: String
-  dataExclusaoSimples: String
-  opcaoMEI: String!
-  dataOpcaoMEI: String
-  dataExclusaoMEI: String
-}
+		args, err := ec.field_Query_buscarProspeccaoConnection_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type Socio {
-  cnpj: String! # CNPJ da empresa associada
-  cnpjBasico: String! # CNPJ Básico da empresa associada
-  identificadorDeSocio: String!
-  nomeSocio: String! # Nome do Sócio
-  cnpjCpfSocio: String!
-  qualificacaoSocio: String!
-  dataEntradaSociedade: String!
-  pais: String
-  representanteLegal: String
-  nomeRepresentante: String
-  qualificacaoRepresentanteLegal: String
-  faixaEtaria: String
-}
+		return e.complexity.Query.BuscarProspeccaoConnection(childComplexity, args["filter"].(*model.ProspeccaoFilter), args["where"].(*model.FiltroExpr), args["texto"].(*string), args["first"].(*int), args["after"].(*string)), true
 
-type CNAE { # Tipo para CNAE
-  codigo: String!
-  descricao: String!
-}
+	case "Query.cnaeByCodigo":
+		if e.complexity.Query.CnaeByCodigo == nil {
+			break
+		}
 
-# TIPO COMBINADO: ProspeccaoDetalhada
-# Este tipo é apenas para o GraphQL, para agrupar resultados de várias tabelas.
-type ProspeccaoDetalhada {
-    empresa: Empresa!
-    estabelecimento: Estabelecimento!
-    socios: [Socio!]! # Uma lista de sócios
-    cnaeFiscal: CNAE # CNAE Fiscal Principal (com descrição)
-    cnaeSecundaria: [CNAE!]! # CNAEs Secundários (com descrições)
-}
+		args, err := ec.field_Query_cnaeByCodigo_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-# INPUT para filtros de prospecção (AGORA COMPLETO)
-input ProspeccaoFilter {
-    cnpj: String # CNPJ completo (para busca exata)
-    razaoSocial: String # Parte da razão social (para busca parcial)
-    nomeFantasia: String # Parte do nome fantasia (para busca parcial)
-    uf: String # UF do estabelecimento
-    municipio: String # Município do estabelecimento (busca exata)
-    situacaoCadastral: String # Situação cadastral do estabelecimento
-    dataSituacaoCadastralMin: String # Data mínima da situação cadastral (YYYY-MM-DD)
-    dataSituacaoCadastralMax: String # Data máxima da situação cadastral (YYYY-MM-DD)
-    porteEmpresa: String # Porte da empresa
-    naturezaJuridica: String # Natureza Jurídica da empresa
-    cnaeFiscal: String # Código CNAE Fiscal principal
-    cnaeFiscalSecundaria: String # Código CNAE Fiscal secundário (busca parcial em string)
-    minCapitalSocial: Float
-    maxCapitalSocial: Float
-    dataInicioAtividadesMin: String # Data mínima de início de atividades (YYYY-MM-DD)
-    dataInicioAtividadesMax: String # Data máxima de início de atividades (YYYY-MM-DD)
-}
+		return e.complexity.Query.CnaeByCodigo(childComplexity, args["codigo"].(string)), true
 
-# Queries (operações de leitura)
-type Query {
-  # Adicionado 'offset' para paginação na query 'empresas'
-  empresas(limit: Int, offset: Int): [Empresa!]!
-  empresa(cnpjBasico: String!): Empresa
-  estabelecimento(id: Int!): Estabelecimento
-  
-  # Queries diretas para entidades (útil para granularidade, mas o resolver precisa existir)
-  sociosByCnpjBasico(cnpjBasico: String!): [Socio!]!
-  cnaeByCodigo(codigo: String!): CNAE
-  
-  # Query principal para prospecção, agora com todos os filtros e paginação
-  buscarProspeccao(filter: ProspeccaoFilter, limit: Int, offset: Int): [ProspeccaoDetalhada!]!
-}
+	case "Query.dataVersion":
+		if e.complexity.Query.DataVersion == nil {
+			break
+		}
 
+		return e.complexity.Query.DataVersion(childComplexity), true
 
-# Mutations (operações de escrita - CREATE, UPDATE, DELETE)
-# Por enquanto, este bloco está comentado.
-# type Mutation {
-#   # createEmpresa(input: CreateEmpresaInput!): Empresa!
-#   # updateEmpresa(id: Int!, input: UpdateEmpresaInput!): Empresa!
-#   # deleteEmpresa(id: Int!): Boolean!
-# }
+	case "Query.empresa":
+		if e.complexity.Query.Empresa == nil {
+			break
+		}
 
-# Inputs para mutations (se fossemos criar)
-# input CreateEmpresaInput {
-#   razaoSocial: String!
-#   # ... outros campos
-# }
+		args, err := ec.field_Query_empresa_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-# input UpdateEmpresaInput {
-#   razaoSocial: String
-#   # ... outros campos que podem ser opcionais
-# }`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.complexity.Query.Empresa(childComplexity, args["cnpjBasico"].(string)), true
 
-// endregion ************************** generated!.gotpl **************************
+	case "Query.empresas":
+		if e.complexity.Query.Empresas == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		args, err := ec.field_Query_empresas_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Query___type_argsName(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Query___type_argsName(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["name"]; !ok {
-		var zeroVal string
-		return zeroVal, nil
-	}
+		return e.complexity.Query.Empresas(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-	if tmp, ok := rawArgs["name"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
-	}
+	case "Query.empresasSimilares":
+		if e.complexity.Query.EmpresasSimilares == nil {
+			break
+		}
 
-	var zeroVal string
-	return zeroVal, nil
-}
+		args, err := ec.field_Query_empresasSimilares_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_buscarProspeccao_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Query_buscarProspeccao_argsFilter(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["filter"] = arg0
-	arg1, err := ec.field_Query_buscarProspeccao_argsLimit(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["limit"] = arg1
-	arg2, err := ec.field_Query_buscarProspeccao_argsOffset(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["offset"] = arg2
-	return args, nil
-}
-func (ec *executionContext) field_Query_buscarProspeccao_argsFilter(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (*model.ProspeccaoFilter, error) {
-	if _, ok := rawArgs["filter"]; !ok {
-		var zeroVal *model.ProspeccaoFilter
-		return zeroVal, nil
-	}
+		return e.complexity.Query.EmpresasSimilares(childComplexity, args["nome"].(string), args["uf"].(*string), args["limiar"].(*float64), args["limit"].(*int)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-	if tmp, ok := rawArgs["filter"]; ok {
-		return ec.unmarshalOProspeccaoFilter2ᚖbackendᚋgraphqlᚋmodelᚐProspeccaoFilter(ctx, tmp)
-	}
+	case "Query.estabelecimento":
+		if e.complexity.Query.Estabelecimento == nil {
+			break
+		}
 
-	var zeroVal *model.ProspeccaoFilter
-	return zeroVal, nil
-}
+		args, err := ec.field_Query_estabelecimento_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_buscarProspeccao_argsLimit(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (*int, error) {
-	if _, ok := rawArgs["limit"]; !ok {
-		var zeroVal *int
-		return zeroVal, nil
-	}
+		return e.complexity.Query.Estabelecimento(childComplexity, args["id"].(int)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-	if tmp, ok := rawArgs["limit"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
-	}
+	case "Query.estabelecimentoPorCnpj":
+		if e.complexity.Query.EstabelecimentoPorCnpj == nil {
+			break
+		}
 
-	var zeroVal *int
-	return zeroVal, nil
-}
+		args, err := ec.field_Query_estabelecimentoPorCnpj_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_buscarProspeccao_argsOffset(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (*int, error) {
-	if _, ok := rawArgs["offset"]; !ok {
-		var zeroVal *int
-		return zeroVal, nil
-	}
+		return e.complexity.Query.EstabelecimentoPorCnpj(childComplexity, args["cnpj"].(models.CNPJ)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
-	if tmp, ok := rawArgs["offset"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
-	}
+	case "Query.mudancas":
+		if e.complexity.Query.Mudancas == nil {
+			break
+		}
 
-	var zeroVal *int
-	return zeroVal, nil
-}
+		args, err := ec.field_Query_mudancas_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_cnaeByCodigo_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Query_cnaeByCodigo_argsCodigo(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["codigo"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Query_cnaeByCodigo_argsCodigo(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["codigo"]; !ok {
-		var zeroVal string
-		return zeroVal, nil
-	}
+		return e.complexity.Query.Mudancas(childComplexity, args["filter"].(*model.MudancaFilter), args["limit"].(*int), args["offset"].(*int)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("codigo"))
-	if tmp, ok := rawArgs["codigo"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
-	}
+	case "Query.prospeccaoPorCnpjs":
+		if e.complexity.Query.ProspeccaoPorCnpjs == nil {
+			break
+		}
 
-	var zeroVal string
-	return zeroVal, nil
-}
+		args, err := ec.field_Query_prospeccaoPorCnpjs_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_empresa_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Query_empresa_argsCnpjBasico(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["cnpjBasico"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Query_empresa_argsCnpjBasico(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["cnpjBasico"]; !ok {
-		var zeroVal string
-		return zeroVal, nil
-	}
+		return e.complexity.Query.ProspeccaoPorCnpjs(childComplexity, args["cnpjs"].([]string)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpjBasico"))
-	if tmp, ok := rawArgs["cnpjBasico"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
-	}
+	case "Query.simples":
+		if e.complexity.Query.Simples == nil {
+			break
+		}
 
-	var zeroVal string
-	return zeroVal, nil
-}
+		args, err := ec.field_Query_simples_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_empresas_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Query_empresas_argsLimit(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["limit"] = arg0
-	arg1, err := ec.field_Query_empresas_argsOffset(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["offset"] = arg1
-	return args, nil
-}
-func (ec *executionContext) field_Query_empresas_argsLimit(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (*int, error) {
-	if _, ok := rawArgs["limit"]; !ok {
-		var zeroVal *int
-		return zeroVal, nil
-	}
+		return e.complexity.Query.Simples(childComplexity, args["cnpjBasico"].(string)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-	if tmp, ok := rawArgs["limit"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
-	}
+	case "Query.sociosByCnpjBasico":
+		if e.complexity.Query.SociosByCnpjBasico == nil {
+			break
+		}
 
-	var zeroVal *int
-	return zeroVal, nil
-}
+		args, err := ec.field_Query_sociosByCnpjBasico_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_empresas_argsOffset(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (*int, error) {
-	if _, ok := rawArgs["offset"]; !ok {
-		var zeroVal *int
-		return zeroVal, nil
-	}
+		return e.complexity.Query.SociosByCnpjBasico(childComplexity, args["cnpjBasico"].(string)), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
-	if tmp, ok := rawArgs["offset"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
-	}
+	case "Referencia.codigo":
+		if e.complexity.Referencia.Codigo == nil {
+			break
+		}
 
-	var zeroVal *int
-	return zeroVal, nil
-}
+		return e.complexity.Referencia.Codigo(childComplexity), true
 
-func (ec *executionContext) field_Query_estabelecimento_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Query_estabelecimento_argsID(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Query_estabelecimento_argsID(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (int, error) {
-	if _, ok := rawArgs["id"]; !ok {
-		var zeroVal int
-		return zeroVal, nil
-	}
+	case "Referencia.descricao":
+		if e.complexity.Referencia.Descricao == nil {
+			break
+		}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-	if tmp, ok := rawArgs["id"]; ok {
-		return ec.unmarshalNInt2int(ctx, tmp)
-	}
+		return e.complexity.Referencia.Descricao(childComplexity), true
 
-	var zeroVal int
-	return zeroVal, nil
-}
+	case "Simples.cnpjBasico":
+		if e.complexity.Simples.CNPJBasico == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_sociosByCnpjBasico_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field_Query_sociosByCnpjBasico_argsCnpjBasico(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["cnpjBasico"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Query_sociosByCnpjBasico_argsCnpjBasico(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (string, error) {
-	if _, ok := rawArgs["cnpjBasico"]; !ok {
-		var zeroVal string
-		return zeroVal, nil
-	}
+		return e.complexity.Simples.CNPJBasico(childComplexity), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpjBasico"))
-	if tmp, ok := rawArgs["cnpjBasico"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
-	}
+	case "Simples.dataExclusaoMEI":
+		if e.complexity.Simples.DataExclusaoMEI == nil {
+			break
+		}
 
-	var zeroVal string
-	return zeroVal, nil
-}
+		return e.complexity.Simples.DataExclusaoMEI(childComplexity), true
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field___Directive_args_argsIncludeDeprecated(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field___Directive_args_argsIncludeDeprecated(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (*bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal *bool
-		return zeroVal, nil
-	}
+	case "Simples.dataExclusaoSimples":
+		if e.complexity.Simples.DataExclusaoSimples == nil {
+			break
+		}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
-	}
+		return e.complexity.Simples.DataExclusaoSimples(childComplexity), true
 
-	var zeroVal *bool
-	return zeroVal, nil
-}
+	case "Simples.dataOpcaoMEI":
+		if e.complexity.Simples.DataOpcaoMEI == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field___Field_args_argsIncludeDeprecated(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field___Field_args_argsIncludeDeprecated(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (*bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal *bool
-		return zeroVal, nil
-	}
+		return e.complexity.Simples.DataOpcaoMEI(childComplexity), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
-	}
+	case "Simples.dataOpcaoSimples":
+		if e.complexity.Simples.DataOpcaoSimples == nil {
+			break
+		}
 
-	var zeroVal *bool
-	return zeroVal, nil
-}
+		return e.complexity.Simples.DataOpcaoSimples(childComplexity), true
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field___Type_enumValues_argsIncludeDeprecated(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field___Type_enumValues_argsIncludeDeprecated(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal bool
-		return zeroVal, nil
-	}
+	case "Simples.opcaoMEI":
+		if e.complexity.Simples.OpcaoMEI == nil {
+			break
+		}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2bool(ctx, tmp)
-	}
+		return e.complexity.Simples.OpcaoMEI(childComplexity), true
 
-	var zeroVal bool
-	return zeroVal, nil
-}
+	case "Simples.opcaoSimples":
+		if e.complexity.Simples.OpcaoSimples == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := ec.field___Type_fields_argsIncludeDeprecated(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
-	ctx context.Context,
-	rawArgs map[string]any,
-) (bool, error) {
-	if _, ok := rawArgs["includeDeprecated"]; !ok {
-		var zeroVal bool
-		return zeroVal, nil
-	}
+		return e.complexity.Simples.OpcaoSimples(childComplexity), true
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2bool(ctx, tmp)
-	}
+	case "Socio.cnpj":
+		if e.complexity.Socio.CNPJ == nil {
+			break
+		}
 
-	var zeroVal bool
-	return zeroVal, nil
-}
+		return e.complexity.Socio.CNPJ(childComplexity), true
 
-// endregion ***************************** args.gotpl *****************************
+	case "Socio.cnpjBasico":
+		if e.complexity.Socio.CNPJBasico == nil {
+			break
+		}
 
-// region    ************************** directives.gotpl **************************
+		return e.complexity.Socio.CNPJBasico(childComplexity), true
 
-// endregion ************************** directives.gotpl **************************
+	case "Socio.cnpjCpfSocio":
+		if e.complexity.Socio.CNPJCPFSocio == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.complexity.Socio.CNPJCPFSocio(childComplexity), true
 
-func (ec *executionContext) _CNAE_codigo(ctx context.Context, field graphql.CollectedField, obj *models.CNAE) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CNAE_codigo(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Socio.dataEntradaSociedade":
+		if e.complexity.Socio.DataEntradaSociedade == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Codigo, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Socio.DataEntradaSociedade(childComplexity), true
+
+	case "Socio.empresa":
+		if e.complexity.Socio.Empresa == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_CNAE_codigo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CNAE",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Socio.Empresa(childComplexity), true
 
-func (ec *executionContext) _CNAE_descricao(ctx context.Context, field graphql.CollectedField, obj *models.CNAE) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_CNAE_descricao(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Socio.faixaEtaria":
+		if e.complexity.Socio.FaixaEtaria == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Descricao, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Socio.FaixaEtaria(childComplexity), true
+
+	case "Socio.identificadorDeSocio":
+		if e.complexity.Socio.IdentificadorDeSocio == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_CNAE_descricao(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CNAE",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Socio.IdentificadorDeSocio(childComplexity), true
 
-func (ec *executionContext) _Empresa_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Empresa_cnpjBasico(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Socio.nomeRepresentante":
+		if e.complexity.Socio.NomeRepresentante == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJBasico, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Socio.NomeRepresentante(childComplexity), true
+
+	case "Socio.nomeSocio":
+		if e.complexity.Socio.NomeSocio == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Empresa_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Empresa",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Socio.NomeSocio(childComplexity), true
 
-func (ec *executionContext) _Empresa_razaoSocial(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Empresa_razaoSocial(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Socio.pais":
+		if e.complexity.Socio.Pais == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.RazaoSocial, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Socio.Pais(childComplexity), true
+
+	case "Socio.paisRef":
+		if e.complexity.Socio.PaisRef == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Empresa_razaoSocial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Empresa",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Socio.PaisRef(childComplexity), true
 
-func (ec *executionContext) _Empresa_naturezaJuridica(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Socio.qualificacaoRepresentanteLegal":
+		if e.complexity.Socio.QualificacaoRepresentanteLegal == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.NaturezaJuridica, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Socio.QualificacaoRepresentanteLegal(childComplexity), true
+
+	case "Socio.qualificacaoRepresentanteLegalRef":
+		if e.complexity.Socio.QualificacaoRepresentanteLegalRef == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Empresa_naturezaJuridica(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Empresa",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Socio.QualificacaoRepresentanteLegalRef(childComplexity), true
 
-func (ec *executionContext) _Empresa_qualificacaoResponsavel(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Socio.qualificacaoSocio":
+		if e.complexity.Socio.QualificacaoSocio == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.QualificacaoResponsavel, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Socio.QualificacaoSocio(childComplexity), true
+
+	case "Socio.qualificacaoSocioRef":
+		if e.complexity.Socio.QualificacaoSocioRef == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Empresa_qualificacaoResponsavel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Empresa",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Socio.QualificacaoSocioRef(childComplexity), true
 
-func (ec *executionContext) _Empresa_porteEmpresa(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Empresa_porteEmpresa(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Socio.representanteLegal":
+		if e.complexity.Socio.RepresentanteLegal == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PorteEmpresa, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Socio.RepresentanteLegal(childComplexity), true
+
+	case "Socio.tipo":
+		if e.complexity.Socio.Tipo == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Empresa_porteEmpresa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Empresa",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Socio.Tipo(childComplexity), true
 
-func (ec *executionContext) _Empresa_enteFederativoResponsavel(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Subscription.progressoImportacao":
+		if e.complexity.Subscription.ProgressoImportacao == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EnteFederativoResponsavel, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.Subscription.ProgressoImportacao(childComplexity), true
+
+	case "VersaoArquivo.arquivo":
+		if e.complexity.VersaoArquivo.Arquivo == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Empresa_enteFederativoResponsavel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Empresa",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VersaoArquivo.Arquivo(childComplexity), true
 
-func (ec *executionContext) _Empresa_capitalSocial(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Empresa_capitalSocial(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "VersaoArquivo.checksum":
+		if e.complexity.VersaoArquivo.Checksum == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CapitalSocial, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.VersaoArquivo.Checksum(childComplexity), true
+
+	case "VersaoArquivo.linhas":
+		if e.complexity.VersaoArquivo.Linhas == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(float64)
-	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Empresa_capitalSocial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Empresa",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VersaoArquivo.Linhas(childComplexity), true
 
-func (ec *executionContext) _Estabelecimento_id(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_id(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "VersaoArquivo.tabela":
+		if e.complexity.VersaoArquivo.Tabela == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.VersaoArquivo.Tabela(childComplexity), true
+
+	case "VersaoDados.arquivos":
+		if e.complexity.VersaoDados.Arquivos == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(int)
-	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Estabelecimento_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VersaoDados.Arquivos(childComplexity), true
 
-func (ec *executionContext) _Estabelecimento_cnpj(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cnpj(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "VersaoDados.concluidaEm":
+		if e.complexity.VersaoDados.ConcluidaEm == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJ, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.VersaoDados.ConcluidaEm(childComplexity), true
+
+	case "VersaoDados.id":
+		if e.complexity.VersaoDados.ID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Estabelecimento_cnpj(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VersaoDados.ID(childComplexity), true
 
-func (ec *executionContext) _Estabelecimento_cnpjFormatado(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "VersaoDados.iniciadaEm":
+		if e.complexity.VersaoDados.IniciadaEm == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Estabelecimento().CnpjFormatado(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.VersaoDados.IniciadaEm(childComplexity), true
+
+	case "VersaoDados.referencia":
+		if e.complexity.VersaoDados.Referencia == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Estabelecimento_cnpjFormatado(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VersaoDados.Referencia(childComplexity), true
 
-func (ec *executionContext) _Estabelecimento_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "VersaoDados.tabelas":
+		if e.complexity.VersaoDados.Tabelas == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJBasico, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.VersaoDados.Tabelas(childComplexity), true
+
+	case "VersaoTabela.carregadaEm":
+		if e.complexity.VersaoTabela.CarregadaEm == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) fieldContext_Estabelecimento_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VersaoTabela.CarregadaEm(childComplexity), true
 
-func (ec *executionContext) _Estabelecimento_cnpjOrdem(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "VersaoTabela.linhas":
+		if e.complexity.VersaoTabela.Linhas == nil {
+			break
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJOrdem, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+
+		return e.complexity.VersaoTabela.Linhas(childComplexity), true
+
+	case "VersaoTabela.referencia":
+		if e.complexity.VersaoTabela.Referencia == nil {
+			break
 		}
-		return graphql.Null
+
+		return e.complexity.VersaoTabela.Referencia(childComplexity), true
+
+	case "VersaoTabela.rejeitadas":
+		if e.complexity.VersaoTabela.Rejeitadas == nil {
+			break
+		}
+
+		return e.complexity.VersaoTabela.Rejeitadas(childComplexity), true
+
+	case "VersaoTabela.tabela":
+		if e.complexity.VersaoTabela.Tabela == nil {
+			break
+		}
+
+		return e.complexity.VersaoTabela.Tabela(childComplexity), true
+
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return 0, false
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_cnpjOrdem(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputFaixaFiltro,
+		ec.unmarshalInputFiltroExpr,
+		ec.unmarshalInputMudancaFilter,
+		ec.unmarshalInputProspeccaoFilter,
+	)
+	first := true
 
-func (ec *executionContext) _Estabelecimento_cnpjDv(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJDV, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
 		}
-		return graphql.Null
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_cnpjDv(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
 }
 
-func (ec *executionContext) _Estabelecimento_matrizFilial(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
 		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MatrizFilial, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+		ec.deferredResults <- ds
+	}()
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_matrizFilial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
 	}
-	return fc, nil
+	return introspection.WrapSchema(ec.Schema()), nil
 }
 
-func (ec *executionContext) _Estabelecimento_nomeFantasia(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.NomeFantasia, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_nomeFantasia(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+var sources = []*ast.Source{
+	{Name: "../schema.graphqls", Input: `# CNPJ completo, numérico ou alfanumérico, formatado ("12.345.678/0001-95", "12.ABC.345/01DE-35") ou não.
+# Os dígitos verificadores são validados e o valor é normalizado para 14 caracteres em maiúsculas;
+# entradas inválidas geram erro com extensions.code = "VALIDACAO".
+scalar CNPJ
+
+# Data sem hora no formato ISO (YYYY-MM-DD). As datas da Receita vêm como YYYYMMDD; as ausentes
+# ("0", "00000000" ou vazias) saem como null.
+scalar Date
+
+# Enums com os códigos da Receita. As descrições dos valores trazem o rótulo e o código, e aparecem
+# na introspecção. Os campos com o código bruto (situacaoCadastral, porteEmpresa, matrizFilial,
+# identificadorDeSocio) continuam disponíveis.
+
+enum SituacaoCadastral {
+  "Nula (01)" NULA
+  "Ativa (02)" ATIVA
+  "Suspensa (03)" SUSPENSA
+  "Inapta (04)" INAPTA
+  "Baixada (08)" BAIXADA
 }
 
-func (ec *executionContext) _Estabelecimento_situacaoCadastral(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SituacaoCadastral, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+# O código 00 (não informado) resolve para null.
+enum PorteEmpresa {
+  "Microempresa (01)" ME
+  "Empresa de pequeno porte (03)" EPP
+  "Demais (05)" DEMAIS
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_situacaoCadastral(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum TipoEstabelecimento {
+  "Matriz (1)" MATRIZ
+  "Filial (2)" FILIAL
 }
 
-func (ec *executionContext) _Estabelecimento_dataSituacaoCadastral(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DataSituacaoCadastral, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+enum TipoSocio {
+  "Pessoa jurídica (1)" PJ
+  "Pessoa física (2)" PF
+  "Estrangeiro (3)" ESTRANGEIRO
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_dataSituacaoCadastral(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+# Tipos de dados (equivalente aos nossos Go Models)
+
+type Empresa {
+  cnpjBasico: String!
+  razaoSocial: String!
+  naturezaJuridica: String!
+  qualificacaoResponsavel: String!
+  porteEmpresa: String!
+  porte: PorteEmpresa # Porte com rótulo (null se não informado)
+  enteFederativoResponsavel: String!
+  capitalSocial: Float!
+  naturezaJuridicaRef: Referencia # Código e descrição da natureza jurídica
+  qualificacaoResponsavelRef: Referencia # Código e descrição da qualificação do responsável
+  estabelecimentos: [Estabelecimento!]! # Matriz e filiais, ordenadas pelo CNPJ
+  matriz: Estabelecimento # Estabelecimento matriz (null se não estiver na base)
+  socios: [Socio!]!
+  simples: Simples # Opção pelo Simples Nacional / MEI (null se a empresa nunca optou)
 }
 
-func (ec *executionContext) _Estabelecimento_motivoSituacaoCadastral(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MotivoSituacaoCadastral, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+type Estabelecimento {
+  id: Int!
+  cnpj: String! # CNPJ bruto (sem formatação; pode ter letras no CNPJ alfanumérico)
+  cnpjFormatado: String! # CNPJ formatado (XX.XXX.XXX/XXXX-XX)
+  cnpjBasico: String!
+  empresa: Empresa # Empresa dona do estabelecimento (pelo CNPJ básico)
+  cnpjOrdem: String!
+  cnpjDv: String!
+  matrizFilial: String!
+  tipo: TipoEstabelecimento # Matriz ou filial, com rótulo
+  nomeFantasia: String
+  situacaoCadastral: String! # Situação Cadastral (código da Receita)
+  situacao: SituacaoCadastral # Situação cadastral com rótulo
+  dataSituacaoCadastral: Date
+  motivoSituacaoCadastral: String
+  motivoSituacaoCadastralRef: Referencia # Código e descrição do motivo da situação cadastral
+  nomeCidadeExterior: String
+  pais: String
+  paisRef: Referencia # Código e descrição do país
+  dataInicioAtividades: Date
+  cnaeFiscal: String! # Código CNAE Fiscal Principal (será um código, precisamos buscar a descrição)
+  cnaePrincipal: CNAE # CNAE fiscal principal com descrição
+  cnaeFiscalSecundaria: String # Códigos CNAE Fiscal Secundário (serão códigos)
+  tipoLogradouro: String!
+  logradouro: String!
+  numero: String!
+  complemento: String
+  bairro: String
+  cep: String!
+  uf: String! # Estado
+  municipio: String!
+  municipioRef: Referencia # Código e descrição do município (tabela da Receita)
+  ddd1: String
+  telefone1: String
+  ddd2: String
+  telefone2: String
+  dddFax: String
+  fax: String
+  correioEletronico: String
+  situacaoEspecial: String
+  dataSituacaoEspecial: Date
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_motivoSituacaoCadastral(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+# Opção pelo Simples Nacional / MEI. A Receita só publica empresas que já optaram por um deles.
+type Simples {
+  cnpjBasico: String!
+  opcaoSimples: String! # S (optante) ou N (excluída do Simples)
+  dataOpcaoSimples: Date
+  dataExclusaoSimples: Date
+  opcaoMEI: String! # S (MEI) ou N (desenquadrada do MEI)
+  dataOpcaoMEI: Date
+  dataExclusaoMEI: Date
 }
 
-func (ec *executionContext) _Estabelecimento_nomeCidadeExterior(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.NomeCidadeExterior, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+type Socio {
+  cnpj: String! # CNPJ da empresa associada
+  cnpjBasico: String! # CNPJ Básico da empresa associada
+  identificadorDeSocio: String!
+  tipo: TipoSocio # Pessoa física, jurídica ou estrangeiro, com rótulo
+  nomeSocio: String! # Nome do Sócio
+  cnpjCpfSocio: String!
+  qualificacaoSocio: String!
+  qualificacaoSocioRef: Referencia # Código e descrição da qualificação do sócio
+  dataEntradaSociedade: Date
+  pais: String
+  paisRef: Referencia # Código e descrição do país
+  representanteLegal: String
+  nomeRepresentante: String
+  qualificacaoRepresentanteLegal: String
+  qualificacaoRepresentanteLegalRef: Referencia # Código e descrição da qualificação do representante legal
+  faixaEtaria: String
+  empresa: Empresa # Empresa da qual é sócio (pelo CNPJ básico)
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_nomeCidadeExterior(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type CNAE { # Tipo para CNAE
+  codigo: String!
+  descricao: String!
 }
 
-func (ec *executionContext) _Estabelecimento_pais(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_pais(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Pais, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+# Código e descrição de uma tabela de domínio da Receita
+# (natureza jurídica, qualificação, país, município, motivo da situação cadastral)
+type Referencia {
+  codigo: String!
+  descricao: String!
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_pais(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+# TIPO COMBINADO: ProspeccaoDetalhada
+# Este tipo é apenas para o GraphQL, para agrupar resultados de várias tabelas.
+type ProspeccaoDetalhada {
+    empresa: Empresa!
+    estabelecimento: Estabelecimento!
+    socios: [Socio!]! # Uma lista de sócios
+    simples: Simples # Opção pelo Simples Nacional / MEI (null se a empresa nunca optou)
+    cnaeFiscal: CNAE # CNAE Fiscal Principal (com descrição)
+    cnaeSecundaria: [CNAE!]! # CNAEs Secundários (com descrições)
+    score: Float # Relevância na busca por texto (ts_rank) ou similaridade do nome em empresasSimilares e nomeSimilar (0 a 1); null nas demais buscas
 }
 
-func (ec *executionContext) _Estabelecimento_dataInicioAtividades(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DataInicioAtividades, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+# Tipos de mudança detectados entre duas cargas mensais da Receita
+enum TipoMudanca {
+  CNPJ_ABERTO # Estabelecimento que não existia na carga anterior
+  CNPJ_BAIXADO # Situação cadastral passou a BAIXADA (08)
+  CNPJ_INAPTO # Situação cadastral passou a INAPTA (04)
+  SITUACAO_ALTERADA # Outras mudanças de situação cadastral
+  ENDERECO_ALTERADO
+  TELEFONE_ALTERADO
+  SOCIO_ENTRADA
+  SOCIO_SAIDA
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_dataInicioAtividades(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+# Uma mudança registrada no log de mudanças entre cargas
+type Mudanca {
+  id: Int!
+  tipo: TipoMudanca!
+  cnpj: String!
+  cnpjBasico: String!
+  uf: String
+  cnaeFiscal: String
+  valorAnterior: String # Valor na carga anterior (ex.: situação, endereço ou sócio que saiu)
+  valorNovo: String # Valor na carga nova
+  detectadaEm: Date! # Data da carga em que a mudança foi detectada
+  referencia: String # Mês de referência (AAAA-MM) dessa carga; null nas mudanças registradas antes de a referência ser gravada
+}
+
+# INPUT para filtros do log de mudanças
+input MudancaFilter {
+    tipos: [TipoMudanca!] # Qualquer um dos tipos informados
+    uf: String
+    cnaeFiscal: String # Código CNAE Fiscal principal
+    referencia: String # Mês de referência da carga que detectou a mudança (AAAA-MM)
+    dataInicio: Date # Data mínima de detecção
+    dataFim: Date # Data máxima de detecção
 }
 
-func (ec *executionContext) _Estabelecimento_cnaeFiscal(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CNAEFiscal, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+# Versão dos dados no ar: a carga mais recente do importador e a origem de cada tabela.
+# Também vai resumida (referencia e concluidaEm) em extensions.dataVersion de toda resposta.
+type VersaoDados {
+  id: Int!
+  referencia: String! # Mês de referência do dump da Receita (AAAA-MM)
+  iniciadaEm: String! # Início da carga (ISO 8601, UTC)
+  concluidaEm: String! # Momento em que a carga entrou no ar (ISO 8601, UTC)
+  tabelas: [VersaoTabela!]! # Tabelas no ar; após cargas parciais ou rollback, podem vir de cargas diferentes
+  arquivos: [VersaoArquivo!]! # Arquivos de origem das tabelas no ar
+}
+
+type VersaoTabela {
+  tabela: String!
+  referencia: String! # Mês de referência da carga de onde a tabela veio
+  linhas: Int!
+  rejeitadas: Int! # Linhas enviadas para a quarentena nessa carga
+  carregadaEm: String! # ISO 8601, UTC
+}
+
+type VersaoArquivo {
+  tabela: String!
+  arquivo: String! # <zip>/<csv>
+  checksum: String! # SHA-256 do zip
+  linhas: Int!
+}
+
+# Fases de uma importação (cmd/importer)
+enum FaseImportacao {
+  PARSE # Conferência do arquivo (checksum) e retomada do checkpoint
+  LOAD # Leitura, validação e carga das linhas em <tabela>_staging
+  INDEX # Criação dos índices da carga nova
+  SWAP # Troca das tabelas e registro das mudanças
+  DONE # Carga no ar
+  FAILED # Importação interrompida por erro (mensagem traz o motivo)
+}
+
+# Evento de progresso de uma importação em andamento
+type ProgressoImportacao {
+  referencia: String! # Mês de referência do dump (AAAA-MM)
+  fase: FaseImportacao!
+  tabela: String
+  arquivo: String # Arquivo em leitura (<zip>/<csv>)
+  linhasProcessadas: Int! # Linhas da tabela já carregadas ou rejeitadas
+  rejeitadas: Int! # Linhas da tabela enviadas para a quarentena
+  etaSegundos: Int # Estimativa para terminar a carga da tabela (null se ainda desconhecida)
+  mensagem: String
+  em: String! # Momento do evento (ISO 8601, UTC)
+}
+
+# Paginação por cursor da prospecção (especificação de conexões do Relay). Os cursores são opacos:
+# apontam para o CNPJ do último resultado visto, então continuam válidos após uma nova carga dos dados.
+type ProspeccaoConnection {
+  edges: [ProspeccaoEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int # Total de estabelecimentos que atendem ao filtro; só é calculado quando pedido
+}
+
+type ProspeccaoEdge {
+  cursor: String!
+  node: ProspeccaoDetalhada!
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean! # true quando a página foi pedida com after
+  startCursor: String
+  endCursor: String # Passe em after para buscar a próxima página
+}
+
+# Campos aceitos nas expressões de filtro (FiltroExpr)
+enum CampoFiltro {
+  CNPJ
+  CNPJ_BASICO
+  NOME_FANTASIA
+  MATRIZ_FILIAL
+  SITUACAO_CADASTRAL
+  DATA_SITUACAO_CADASTRAL
+  DATA_INICIO_ATIVIDADES
+  CNAE_FISCAL
+  CNAE_FISCAL_SECUNDARIA # Vale para cada CNAE secundário: o estabelecimento passa se algum atender
+  UF
+  MUNICIPIO
+  RAZAO_SOCIAL
+  NATUREZA_JURIDICA
+  PORTE_EMPRESA
+  CAPITAL_SOCIAL
+  OPCAO_SIMPLES
+  DATA_OPCAO_SIMPLES
+  DATA_EXCLUSAO_SIMPLES
+  OPCAO_MEI
+  DATA_OPCAO_MEI
+  DATA_EXCLUSAO_MEI
+}
+
+# Faixa de valores de um predicado range; os limites são inclusivos e um deles pode faltar
+input FaixaFiltro {
+  min: String
+  max: String
+}
+
+# Expressão de filtro da prospecção. Cada nó tem exatamente um entre and, or, not e um predicado:
+# campo com um único operador (eq, in, range, prefix ou contains). Os valores são texto; números como
+# capital social vão como "100000" e datas como "2024-01-31". prefix e contains não diferenciam maiúsculas.
+# Limites: 6 níveis de and/or/not e 50 predicados por expressão.
+# Ex.: { or: [{ and: [{ campo: CNAE_FISCAL, prefix: "47" }, { campo: UF, eq: "SP" }] }, ...] }
+input FiltroExpr {
+  and: [FiltroExpr!]
+  or: [FiltroExpr!]
+  not: FiltroExpr
+  campo: CampoFiltro
+  eq: String
+  in: [String!]
+  range: FaixaFiltro
+  prefix: String
+  contains: String
+}
+
+# Resultado de uma entrada de prospeccaoPorCnpjs, na mesma posição em que ela foi informada
+type ProspeccaoLoteItem {
+  entrada: String! # Valor como foi informado
+  cnpj: String # CNPJ (14 caracteres) ou CNPJ básico (8) normalizado; null se a entrada é inválida
+  encontrado: Boolean! # false se a entrada é inválida ou o CNPJ não está na base
+  erro: String # Motivo da recusa de uma entrada inválida
+  prospeccao: ProspeccaoDetalhada # Para um CNPJ básico, os dados da matriz
+}
+
+# Como a lista cnaes de ProspeccaoFilter é comparada aos CNAEs do estabelecimento
+enum ModoFiltroCNAE {
+  QUALQUER # O CNAE principal ou algum secundário está na lista
+  TODOS # Todos os códigos da lista estão entre o principal e os secundários
+  PRINCIPAL # Só o CNAE principal, que deve estar na lista
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_cnaeFiscal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+# INPUT para filtros de prospecção (AGORA COMPLETO)
+# Os campos <campo>In e <campo>NotIn aceitam listas; uma lista vazia é ignorada, como um campo não informado.
+input ProspeccaoFilter {
+    cnpj: CNPJ # CNPJ completo, numérico ou alfanumérico (para busca exata)
+    razaoSocial: String # Parte da razão social (para busca parcial)
+    nomeFantasia: String # Parte do nome fantasia (para busca parcial)
+    nomeSimilar: String # Nome parecido com a razão social ou o nome fantasia, como em empresasSimilares; os resultados vêm do mais para o menos parecido
+    nomeSimilarLimiar: Float # Similaridade mínima de nomeSimilar, de 0 a 1 (padrão SIMILARIDADE_LIMIAR, 0.3)
+    uf: String # UF do estabelecimento
+    ufIn: [String!] # Qualquer uma das UFs (ex.: ["SP", "RJ", "MG"])
+    ufNotIn: [String!] # Nenhuma das UFs
+    municipio: String # Município do estabelecimento (busca parcial: contém o texto, sem diferenciar maiúsculas)
+    municipioIn: [String!] # Qualquer um dos municípios (códigos da Receita)
+    municipioNotIn: [String!]
+    situacaoCadastral: String # Situação cadastral do estabelecimento (código da Receita)
+    situacao: SituacaoCadastral # Situação cadastral pelo rótulo (alternativa a situacaoCadastral)
+    situacaoCadastralIn: [String!] # Qualquer uma das situações (códigos da Receita)
+    situacaoCadastralNotIn: [String!] # Nenhuma das situações (ex.: ["08"] deixa as baixadas de fora)
+    situacaoIn: [SituacaoCadastral!] # Somada a situacaoCadastralIn
+    situacaoNotIn: [SituacaoCadastral!] # Somada a situacaoCadastralNotIn
+    dataSituacaoCadastralMin: Date # Data mínima da situação cadastral
+    dataSituacaoCadastralMax: Date # Data máxima da situação cadastral
+    porteEmpresa: String # Porte da empresa (código da Receita)
+    porte: PorteEmpresa # Porte pelo rótulo (alternativa a porteEmpresa)
+    porteEmpresaIn: [String!]
+    porteEmpresaNotIn: [String!]
+    porteIn: [PorteEmpresa!] # Somada a porteEmpresaIn
+    porteNotIn: [PorteEmpresa!] # Somada a porteEmpresaNotIn
+    tipoEstabelecimento: TipoEstabelecimento # Só matrizes ou só filiais
+    naturezaJuridica: String # Natureza Jurídica da empresa
+    naturezaJuridicaIn: [String!]
+    naturezaJuridicaNotIn: [String!]
+    cnaeFiscal: String # Código CNAE Fiscal principal
+    cnaeFiscalIn: [String!] # Qualquer um dos CNAEs principais
+    cnaeFiscalNotIn: [String!]
+    cnaeFiscalSecundaria: String # Código CNAE Fiscal secundário (código exato; "4711" não encontra "4711302")
+    cnaes: [String!] # Códigos CNAE (principal e secundários), comparados conforme cnaesModo
+    cnaesModo: ModoFiltroCNAE = QUALQUER
+    minCapitalSocial: Float
+    maxCapitalSocial: Float
+    dataInicioAtividadesMin: Date # Data mínima de início de atividades
+    dataInicioAtividadesMax: Date # Data máxima de início de atividades
+    # Simples Nacional / MEI: qualquer um destes filtros deixa de fora as empresas que nunca optaram
+    opcaoSimples: String # S (optante) ou N (excluída do Simples)
+    opcaoMEI: String # S (MEI) ou N (desenquadrada do MEI)
+    dataOpcaoSimplesMin: Date
+    dataOpcaoSimplesMax: Date
+    dataExclusaoSimplesMin: Date
+    dataExclusaoSimplesMax: Date
+    dataOpcaoMEIMin: Date
+    dataOpcaoMEIMax: Date
+    dataExclusaoMEIMin: Date
+    dataExclusaoMEIMax: Date
 }
 
-func (ec *executionContext) _Estabelecimento_cnaeFiscalSecundaria(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CNAEFiscalSecundaria, nil
-	})
+# Queries (operações de leitura)
+type Query {
+  # Adicionado 'offset' para paginação na query 'empresas'; limit tem padrão 20 e máximo 100
+  empresas(limit: Int, offset: Int): [Empresa!]!
+  empresa(cnpjBasico: String!): Empresa
+  estabelecimento(id: Int!): Estabelecimento
+  estabelecimentoPorCnpj(cnpj: CNPJ!): Estabelecimento # null se o CNPJ é válido mas não está na base
+  
+  # Queries diretas para entidades (útil para granularidade, mas o resolver precisa existir)
+  sociosByCnpjBasico(cnpjBasico: String!): [Socio!]!
+  cnaeByCodigo(codigo: String!): CNAE
+  simples(cnpjBasico: String!): Simples # null se a empresa nunca optou pelo Simples nem pelo MEI
+  
+  # Query principal para prospecção, agora com todos os filtros e paginação
+  # where combina filtros com and/or/not; quando informado junto com filter, os dois valem (AND)
+  # texto busca na razão social, no nome fantasia e na descrição do CNAE principal, sem diferenciar acentos
+  # e por radical ("padarias" encontra "PADARIA"); aceita "frase exata", or e -exclusão. Com texto, os
+  # resultados vêm do mais para o menos relevante (score) em vez da ordem de CNPJ. limit tem padrão 20 e
+  # máximo 100.
+  buscarProspeccao(filter: ProspeccaoFilter, where: FiltroExpr, texto: String, limit: Int, offset: Int): [ProspeccaoDetalhada!]!
+  # Mesma busca paginada por cursor: first resultados (padrão 20, máximo 100) após after
+  buscarProspeccaoConnection(filter: ProspeccaoFilter, where: FiltroExpr, texto: String, first: Int, after: String): ProspeccaoConnection!
+  # Consulta em lote de CNPJs completos ou básicos (até LOTE_CNPJS_MAX, padrão 1000), na ordem informada
+  prospeccaoPorCnpjs(cnpjs: [String!]!): [ProspeccaoLoteItem!]!
+  # Busca tolerante a erros de digitação pela razão social ou pelo nome fantasia, sem diferenciar maiúsculas
+  # ("padaria sao jose" encontra "PANIFICADORA S JOSE LTDA"). Traz os limit (padrão 20, máximo 100) mais
+  # parecidos, com score (similaridade de trigramas, 0 a 1) de pelo menos limiar (padrão SIMILARIDADE_LIMIAR, 0.3)
+  empresasSimilares(nome: String!, uf: String, limiar: Float, limit: Int): [ProspeccaoDetalhada!]!
+
+  # Log de mudanças entre as cargas mensais (novos CNPJs, baixas, endereços, sócios...)
+  mudancas(filter: MudancaFilter, limit: Int, offset: Int): [Mudanca!]!
+
+  # Versão dos dados no ar (null se o importador ainda não fez nenhuma carga)
+  dataVersion: VersaoDados
+}
+
+# Subscriptions (via websocket, no mesmo endpoint /query)
+type Subscription {
+  # Progresso da importação em andamento, publicado pelo importador
+  progressoImportacao: ProgressoImportacao!
+}
+
+
+# Mutations (operações de escrita - CREATE, UPDATE, DELETE)
+# Por enquanto, este bloco está comentado.
+# type Mutation {
+#   # createEmpresa(input: CreateEmpresaInput!): Empresa!
+#   # updateEmpresa(id: Int!, input: UpdateEmpresaInput!): Empresa!
+#   # deleteEmpresa(id: Int!): Boolean!
+# }
+
+# Inputs para mutations (se fossemos criar)
+# input CreateEmpresaInput {
+#   razaoSocial: String!
+#   # ... outros campos
+# }
+
+# input UpdateEmpresaInput {
+#   razaoSocial: String
+#   # ... outros campos que podem ser opcionais
+# }`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query___type_argsName(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	args["name"] = arg0
+	return args, nil
 }
+func (ec *executionContext) field_Query___type_argsName(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["name"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_cnaeFiscalSecundaria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+	if tmp, ok := rawArgs["name"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_tipoLogradouro(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
+func (ec *executionContext) field_Query_buscarProspeccaoConnection_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_buscarProspeccaoConnection_argsFilter(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.TipoLogradouro, nil
-	})
+	args["filter"] = arg0
+	arg1, err := ec.field_Query_buscarProspeccaoConnection_argsWhere(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	args["where"] = arg1
+	arg2, err := ec.field_Query_buscarProspeccaoConnection_argsTexto(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["texto"] = arg2
+	arg3, err := ec.field_Query_buscarProspeccaoConnection_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
+	arg4, err := ec.field_Query_buscarProspeccaoConnection_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg4
+	return args, nil
 }
+func (ec *executionContext) field_Query_buscarProspeccaoConnection_argsFilter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*model.ProspeccaoFilter, error) {
+	if _, ok := rawArgs["filter"]; !ok {
+		var zeroVal *model.ProspeccaoFilter
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_tipoLogradouro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+	if tmp, ok := rawArgs["filter"]; ok {
+		return ec.unmarshalOProspeccaoFilter2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐProspeccaoFilter(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal *model.ProspeccaoFilter
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_logradouro(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_logradouro(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Logradouro, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) field_Query_buscarProspeccaoConnection_argsWhere(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*model.FiltroExpr, error) {
+	if _, ok := rawArgs["where"]; !ok {
+		var zeroVal *model.FiltroExpr
+		return zeroVal, nil
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
+	if tmp, ok := rawArgs["where"]; ok {
+		return ec.unmarshalOFiltroExpr2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExpr(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+
+	var zeroVal *model.FiltroExpr
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_logradouro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_buscarProspeccaoConnection_argsTexto(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["texto"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
 	}
-	return fc, nil
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("texto"))
+	if tmp, ok := rawArgs["texto"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_numero(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_numero(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Query_buscarProspeccaoConnection_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["first"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Numero, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+
+	var zeroVal *int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_numero(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_buscarProspeccaoConnection_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["after"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
 	}
-	return fc, nil
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_complemento(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_complemento(ctx, field)
+func (ec *executionContext) field_Query_buscarProspeccao_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_buscarProspeccao_argsFilter(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Complemento, nil
-	})
+	args["filter"] = arg0
+	arg1, err := ec.field_Query_buscarProspeccao_argsWhere(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_Estabelecimento_complemento(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+		return nil, err
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Estabelecimento_bairro(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_bairro(ctx, field)
+	args["where"] = arg1
+	arg2, err := ec.field_Query_buscarProspeccao_argsTexto(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Bairro, nil
-	})
+	args["texto"] = arg2
+	arg3, err := ec.field_Query_buscarProspeccao_argsLimit(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["limit"] = arg3
+	arg4, err := ec.field_Query_buscarProspeccao_argsOffset(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	args["offset"] = arg4
+	return args, nil
 }
+func (ec *executionContext) field_Query_buscarProspeccao_argsFilter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*model.ProspeccaoFilter, error) {
+	if _, ok := rawArgs["filter"]; !ok {
+		var zeroVal *model.ProspeccaoFilter
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_bairro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+	if tmp, ok := rawArgs["filter"]; ok {
+		return ec.unmarshalOProspeccaoFilter2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐProspeccaoFilter(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal *model.ProspeccaoFilter
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_cep(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_cep(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CEP, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) field_Query_buscarProspeccao_argsWhere(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*model.FiltroExpr, error) {
+	if _, ok := rawArgs["where"]; !ok {
+		var zeroVal *model.FiltroExpr
+		return zeroVal, nil
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
+	if tmp, ok := rawArgs["where"]; ok {
+		return ec.unmarshalOFiltroExpr2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExpr(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+
+	var zeroVal *model.FiltroExpr
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_cep(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_buscarProspeccao_argsTexto(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["texto"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Estabelecimento_uf(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_uf(ctx, field)
-	if err != nil {
-		return graphql.Null
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("texto"))
+	if tmp, ok := rawArgs["texto"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.UF, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_buscarProspeccao_argsLimit(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["limit"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+	if tmp, ok := rawArgs["limit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+
+	var zeroVal *int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_uf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_buscarProspeccao_argsOffset(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["offset"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
 	}
-	return fc, nil
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+	if tmp, ok := rawArgs["offset"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_municipio(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_municipio(ctx, field)
+func (ec *executionContext) field_Query_cnaeByCodigo_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_cnaeByCodigo_argsCodigo(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Municipio, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["codigo"] = arg0
+	return args, nil
 }
+func (ec *executionContext) field_Query_cnaeByCodigo_argsCodigo(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["codigo"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_municipio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("codigo"))
+	if tmp, ok := rawArgs["codigo"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_ddd1(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_ddd1(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DDD1, nil
-	})
+func (ec *executionContext) field_Query_empresa_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_empresa_argsCnpjBasico(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	args["cnpjBasico"] = arg0
+	return args, nil
 }
+func (ec *executionContext) field_Query_empresa_argsCnpjBasico(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["cnpjBasico"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_ddd1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpjBasico"))
+	if tmp, ok := rawArgs["cnpjBasico"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_telefone1(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_telefone1(ctx, field)
+func (ec *executionContext) field_Query_empresasSimilares_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_empresasSimilares_argsNome(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Telefone1, nil
-	})
+	args["nome"] = arg0
+	arg1, err := ec.field_Query_empresasSimilares_argsUf(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["uf"] = arg1
+	arg2, err := ec.field_Query_empresasSimilares_argsLimiar(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	args["limiar"] = arg2
+	arg3, err := ec.field_Query_empresasSimilares_argsLimit(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg3
+	return args, nil
 }
+func (ec *executionContext) field_Query_empresasSimilares_argsNome(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["nome"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_telefone1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("nome"))
+	if tmp, ok := rawArgs["nome"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_ddd2(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_ddd2(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Query_empresasSimilares_argsUf(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*string, error) {
+	if _, ok := rawArgs["uf"]; !ok {
+		var zeroVal *string
+		return zeroVal, nil
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DDD2, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("uf"))
+	if tmp, ok := rawArgs["uf"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
 	}
-	if resTmp == nil {
-		return graphql.Null
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_empresasSimilares_argsLimiar(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*float64, error) {
+	if _, ok := rawArgs["limiar"]; !ok {
+		var zeroVal *float64
+		return zeroVal, nil
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limiar"))
+	if tmp, ok := rawArgs["limiar"]; ok {
+		return ec.unmarshalOFloat2ᚖfloat64(ctx, tmp)
+	}
+
+	var zeroVal *float64
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_ddd2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_empresasSimilares_argsLimit(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["limit"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
 	}
-	return fc, nil
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+	if tmp, ok := rawArgs["limit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_telefone2(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_telefone2(ctx, field)
+func (ec *executionContext) field_Query_empresas_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_empresas_argsLimit(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Telefone2, nil
-	})
+	args["limit"] = arg0
+	arg1, err := ec.field_Query_empresas_argsOffset(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	args["offset"] = arg1
+	return args, nil
 }
+func (ec *executionContext) field_Query_empresas_argsLimit(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["limit"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_telefone2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+	if tmp, ok := rawArgs["limit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Estabelecimento_dddFax(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_dddFax(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DDDFax, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_empresas_argsOffset(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["offset"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
 	}
-	if resTmp == nil {
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+	if tmp, ok := rawArgs["offset"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+
+	var zeroVal *int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_dddFax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_estabelecimentoPorCnpj_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_estabelecimentoPorCnpj_argsCnpj(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["cnpj"] = arg0
+	return args, nil
 }
+func (ec *executionContext) field_Query_estabelecimentoPorCnpj_argsCnpj(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (models.CNPJ, error) {
+	if _, ok := rawArgs["cnpj"]; !ok {
+		var zeroVal models.CNPJ
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) _Estabelecimento_fax(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_fax(ctx, field)
-	if err != nil {
-		return graphql.Null
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpj"))
+	if tmp, ok := rawArgs["cnpj"]; ok {
+		return ec.unmarshalNCNPJ2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNPJ(ctx, tmp)
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Fax, nil
-	})
+
+	var zeroVal models.CNPJ
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_estabelecimento_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_estabelecimento_argsID(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	args["id"] = arg0
+	return args, nil
 }
+func (ec *executionContext) field_Query_estabelecimento_argsID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (int, error) {
+	if _, ok := rawArgs["id"]; !ok {
+		var zeroVal int
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_fax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNInt2int(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_correioEletronico(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
+func (ec *executionContext) field_Query_mudancas_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_mudancas_argsFilter(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CorreioEletronico, nil
-	})
+	args["filter"] = arg0
+	arg1, err := ec.field_Query_mudancas_argsLimit(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["limit"] = arg1
+	arg2, err := ec.field_Query_mudancas_argsOffset(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	args["offset"] = arg2
+	return args, nil
 }
+func (ec *executionContext) field_Query_mudancas_argsFilter(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*model.MudancaFilter, error) {
+	if _, ok := rawArgs["filter"]; !ok {
+		var zeroVal *model.MudancaFilter
+		return zeroVal, nil
+	}
 
-func (ec *executionContext) fieldContext_Estabelecimento_correioEletronico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+	if tmp, ok := rawArgs["filter"]; ok {
+		return ec.unmarshalOMudancaFilter2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐMudancaFilter(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal *model.MudancaFilter
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_situacaoEspecial(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SituacaoEspecial, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) field_Query_mudancas_argsLimit(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["limit"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
 	}
-	if resTmp == nil {
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+	if tmp, ok := rawArgs["limit"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+
+	var zeroVal *int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_situacaoEspecial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_mudancas_argsOffset(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*int, error) {
+	if _, ok := rawArgs["offset"]; !ok {
+		var zeroVal *int
+		return zeroVal, nil
 	}
-	return fc, nil
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+	if tmp, ok := rawArgs["offset"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _Estabelecimento_dataSituacaoEspecial(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
+func (ec *executionContext) field_Query_prospeccaoPorCnpjs_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_prospeccaoPorCnpjs_argsCnpjs(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DataSituacaoEspecial, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	args["cnpjs"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_prospeccaoPorCnpjs_argsCnpjs(
+	ctx context.Context,
+	rawArgs map[string]any,
+) ([]string, error) {
+	if _, ok := rawArgs["cnpjs"]; !ok {
+		var zeroVal []string
+		return zeroVal, nil
 	}
-	if resTmp == nil {
-		return graphql.Null
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpjs"))
+	if tmp, ok := rawArgs["cnpjs"]; ok {
+		return ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+
+	var zeroVal []string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_Estabelecimento_dataSituacaoEspecial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) field_Query_simples_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_simples_argsCnpjBasico(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["cnpjBasico"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_simples_argsCnpjBasico(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["cnpjBasico"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpjBasico"))
+	if tmp, ok := rawArgs["cnpjBasico"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_sociosByCnpjBasico_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_sociosByCnpjBasico_argsCnpjBasico(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["cnpjBasico"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_sociosByCnpjBasico_argsCnpjBasico(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (string, error) {
+	if _, ok := rawArgs["cnpjBasico"]; !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpjBasico"))
+	if tmp, ok := rawArgs["cnpjBasico"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Directive_args_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Directive_args_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal *bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Field_args_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Field_args_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal *bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Type_enumValues_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Type_enumValues_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field___Type_fields_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (bool, error) {
+	if _, ok := rawArgs["includeDeprecated"]; !ok {
+		var zeroVal bool
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _CNAE_codigo(ctx context.Context, field graphql.CollectedField, obj *models.CNAE) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CNAE_codigo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Codigo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CNAE_codigo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Estabelecimento",
+		Object:     "CNAE",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3131,8 +2869,8 @@ func (ec *executionContext) fieldContext_Estabelecimento_dataSituacaoEspecial(_
 	return fc, nil
 }
 
-func (ec *executionContext) _ProspeccaoDetalhada_empresa(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProspeccaoDetalhada_empresa(ctx, field)
+func (ec *executionContext) _CNAE_descricao(ctx context.Context, field graphql.CollectedField, obj *models.CNAE) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CNAE_descricao(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3145,7 +2883,7 @@ func (ec *executionContext) _ProspeccaoDetalhada_empresa(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Empresa, nil
+		return obj.Descricao, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3157,42 +2895,26 @@ func (ec *executionContext) _ProspeccaoDetalhada_empresa(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*models.Empresa)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProspeccaoDetalhada_empresa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CNAE_descricao(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProspeccaoDetalhada",
+		Object:     "CNAE",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cnpjBasico":
-				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
-			case "razaoSocial":
-				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
-			case "naturezaJuridica":
-				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
-			case "qualificacaoResponsavel":
-				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
-			case "porteEmpresa":
-				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
-			case "enteFederativoResponsavel":
-				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
-			case "capitalSocial":
-				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProspeccaoDetalhada_estabelecimento(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProspeccaoDetalhada_estabelecimento(ctx, field)
+func (ec *executionContext) _Empresa_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_cnpjBasico(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3205,7 +2927,7 @@ func (ec *executionContext) _ProspeccaoDetalhada_estabelecimento(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Estabelecimento, nil
+		return obj.CNPJBasico, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3217,94 +2939,26 @@ func (ec *executionContext) _ProspeccaoDetalhada_estabelecimento(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*models.Estabelecimento)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProspeccaoDetalhada_estabelecimento(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProspeccaoDetalhada",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Estabelecimento_id(ctx, field)
-			case "cnpj":
-				return ec.fieldContext_Estabelecimento_cnpj(ctx, field)
-			case "cnpjFormatado":
-				return ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
-			case "cnpjBasico":
-				return ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
-			case "cnpjOrdem":
-				return ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
-			case "cnpjDv":
-				return ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
-			case "matrizFilial":
-				return ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
-			case "nomeFantasia":
-				return ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
-			case "situacaoCadastral":
-				return ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
-			case "dataSituacaoCadastral":
-				return ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
-			case "motivoSituacaoCadastral":
-				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
-			case "nomeCidadeExterior":
-				return ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
-			case "pais":
-				return ec.fieldContext_Estabelecimento_pais(ctx, field)
-			case "dataInicioAtividades":
-				return ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
-			case "cnaeFiscal":
-				return ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
-			case "cnaeFiscalSecundaria":
-				return ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
-			case "tipoLogradouro":
-				return ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
-			case "logradouro":
-				return ec.fieldContext_Estabelecimento_logradouro(ctx, field)
-			case "numero":
-				return ec.fieldContext_Estabelecimento_numero(ctx, field)
-			case "complemento":
-				return ec.fieldContext_Estabelecimento_complemento(ctx, field)
-			case "bairro":
-				return ec.fieldContext_Estabelecimento_bairro(ctx, field)
-			case "cep":
-				return ec.fieldContext_Estabelecimento_cep(ctx, field)
-			case "uf":
-				return ec.fieldContext_Estabelecimento_uf(ctx, field)
-			case "municipio":
-				return ec.fieldContext_Estabelecimento_municipio(ctx, field)
-			case "ddd1":
-				return ec.fieldContext_Estabelecimento_ddd1(ctx, field)
-			case "telefone1":
-				return ec.fieldContext_Estabelecimento_telefone1(ctx, field)
-			case "ddd2":
-				return ec.fieldContext_Estabelecimento_ddd2(ctx, field)
-			case "telefone2":
-				return ec.fieldContext_Estabelecimento_telefone2(ctx, field)
-			case "dddFax":
-				return ec.fieldContext_Estabelecimento_dddFax(ctx, field)
-			case "fax":
-				return ec.fieldContext_Estabelecimento_fax(ctx, field)
-			case "correioEletronico":
-				return ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
-			case "situacaoEspecial":
-				return ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
-			case "dataSituacaoEspecial":
-				return ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Estabelecimento", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProspeccaoDetalhada_socios(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProspeccaoDetalhada_socios(ctx, field)
+func (ec *executionContext) _Empresa_razaoSocial(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_razaoSocial(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3317,7 +2971,7 @@ func (ec *executionContext) _ProspeccaoDetalhada_socios(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Socios, nil
+		return obj.RazaoSocial, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3329,52 +2983,26 @@ func (ec *executionContext) _ProspeccaoDetalhada_socios(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*models.Socio)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocioᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProspeccaoDetalhada_socios(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_razaoSocial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProspeccaoDetalhada",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cnpj":
-				return ec.fieldContext_Socio_cnpj(ctx, field)
-			case "cnpjBasico":
-				return ec.fieldContext_Socio_cnpjBasico(ctx, field)
-			case "identificadorDeSocio":
-				return ec.fieldContext_Socio_identificadorDeSocio(ctx, field)
-			case "nomeSocio":
-				return ec.fieldContext_Socio_nomeSocio(ctx, field)
-			case "cnpjCpfSocio":
-				return ec.fieldContext_Socio_cnpjCpfSocio(ctx, field)
-			case "qualificacaoSocio":
-				return ec.fieldContext_Socio_qualificacaoSocio(ctx, field)
-			case "dataEntradaSociedade":
-				return ec.fieldContext_Socio_dataEntradaSociedade(ctx, field)
-			case "pais":
-				return ec.fieldContext_Socio_pais(ctx, field)
-			case "representanteLegal":
-				return ec.fieldContext_Socio_representanteLegal(ctx, field)
-			case "nomeRepresentante":
-				return ec.fieldContext_Socio_nomeRepresentante(ctx, field)
-			case "qualificacaoRepresentanteLegal":
-				return ec.fieldContext_Socio_qualificacaoRepresentanteLegal(ctx, field)
-			case "faixaEtaria":
-				return ec.fieldContext_Socio_faixaEtaria(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Socio", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProspeccaoDetalhada_cnaeFiscal(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProspeccaoDetalhada_cnaeFiscal(ctx, field)
+func (ec *executionContext) _Empresa_naturezaJuridica(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3387,41 +3015,38 @@ func (ec *executionContext) _ProspeccaoDetalhada_cnaeFiscal(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CNAEFiscal, nil
+		return obj.NaturezaJuridica, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*models.CNAE)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProspeccaoDetalhada_cnaeFiscal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_naturezaJuridica(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProspeccaoDetalhada",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "codigo":
-				return ec.fieldContext_CNAE_codigo(ctx, field)
-			case "descricao":
-				return ec.fieldContext_CNAE_descricao(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CNAE", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProspeccaoDetalhada_cnaeSecundaria(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProspeccaoDetalhada_cnaeSecundaria(ctx, field)
+func (ec *executionContext) _Empresa_qualificacaoResponsavel(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3434,7 +3059,7 @@ func (ec *executionContext) _ProspeccaoDetalhada_cnaeSecundaria(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CNAESecundaria, nil
+		return obj.QualificacaoResponsavel, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3446,32 +3071,26 @@ func (ec *executionContext) _ProspeccaoDetalhada_cnaeSecundaria(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*models.CNAE)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNCNAE2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAEᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProspeccaoDetalhada_cnaeSecundaria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_qualificacaoResponsavel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProspeccaoDetalhada",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "codigo":
-				return ec.fieldContext_CNAE_codigo(ctx, field)
-			case "descricao":
-				return ec.fieldContext_CNAE_descricao(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CNAE", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_empresas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_empresas(ctx, field)
+func (ec *executionContext) _Empresa_porteEmpresa(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_porteEmpresa(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3484,7 +3103,7 @@ func (ec *executionContext) _Query_empresas(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Empresas(rctx, fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		return obj.PorteEmpresa, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3496,53 +3115,26 @@ func (ec *executionContext) _Query_empresas(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*models.Empresa)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEmpresa2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresaᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_empresas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_porteEmpresa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cnpjBasico":
-				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
-			case "razaoSocial":
-				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
-			case "naturezaJuridica":
-				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
-			case "qualificacaoResponsavel":
-				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
-			case "porteEmpresa":
-				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
-			case "enteFederativoResponsavel":
-				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
-			case "capitalSocial":
-				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_empresas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_empresa(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_empresa(ctx, field)
+func (ec *executionContext) _Empresa_porte(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_porte(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3555,7 +3147,7 @@ func (ec *executionContext) _Query_empresa(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Empresa(rctx, fc.Args["cnpjBasico"].(string))
+		return ec.resolvers.Empresa().Porte(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3564,53 +3156,26 @@ func (ec *executionContext) _Query_empresa(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*models.Empresa)
+	res := resTmp.(*model.PorteEmpresa)
 	fc.Result = res
-	return ec.marshalOEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, field.Selections, res)
+	return ec.marshalOPorteEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_empresa(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_porte(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cnpjBasico":
-				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
-			case "razaoSocial":
-				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
-			case "naturezaJuridica":
-				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
-			case "qualificacaoResponsavel":
-				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
-			case "porteEmpresa":
-				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
-			case "enteFederativoResponsavel":
-				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
-			case "capitalSocial":
-				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
+			return nil, errors.New("field of type PorteEmpresa does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_empresa_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_estabelecimento(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_estabelecimento(ctx, field)
+func (ec *executionContext) _Empresa_enteFederativoResponsavel(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3623,114 +3188,38 @@ func (ec *executionContext) _Query_estabelecimento(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Estabelecimento(rctx, fc.Args["id"].(int))
+		return obj.EnteFederativoResponsavel, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*models.Estabelecimento)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_estabelecimento(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_enteFederativoResponsavel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Estabelecimento_id(ctx, field)
-			case "cnpj":
-				return ec.fieldContext_Estabelecimento_cnpj(ctx, field)
-			case "cnpjFormatado":
-				return ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
-			case "cnpjBasico":
-				return ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
-			case "cnpjOrdem":
-				return ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
-			case "cnpjDv":
-				return ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
-			case "matrizFilial":
-				return ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
-			case "nomeFantasia":
-				return ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
-			case "situacaoCadastral":
-				return ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
-			case "dataSituacaoCadastral":
-				return ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
-			case "motivoSituacaoCadastral":
-				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
-			case "nomeCidadeExterior":
-				return ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
-			case "pais":
-				return ec.fieldContext_Estabelecimento_pais(ctx, field)
-			case "dataInicioAtividades":
-				return ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
-			case "cnaeFiscal":
-				return ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
-			case "cnaeFiscalSecundaria":
-				return ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
-			case "tipoLogradouro":
-				return ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
-			case "logradouro":
-				return ec.fieldContext_Estabelecimento_logradouro(ctx, field)
-			case "numero":
-				return ec.fieldContext_Estabelecimento_numero(ctx, field)
-			case "complemento":
-				return ec.fieldContext_Estabelecimento_complemento(ctx, field)
-			case "bairro":
-				return ec.fieldContext_Estabelecimento_bairro(ctx, field)
-			case "cep":
-				return ec.fieldContext_Estabelecimento_cep(ctx, field)
-			case "uf":
-				return ec.fieldContext_Estabelecimento_uf(ctx, field)
-			case "municipio":
-				return ec.fieldContext_Estabelecimento_municipio(ctx, field)
-			case "ddd1":
-				return ec.fieldContext_Estabelecimento_ddd1(ctx, field)
-			case "telefone1":
-				return ec.fieldContext_Estabelecimento_telefone1(ctx, field)
-			case "ddd2":
-				return ec.fieldContext_Estabelecimento_ddd2(ctx, field)
-			case "telefone2":
-				return ec.fieldContext_Estabelecimento_telefone2(ctx, field)
-			case "dddFax":
-				return ec.fieldContext_Estabelecimento_dddFax(ctx, field)
-			case "fax":
-				return ec.fieldContext_Estabelecimento_fax(ctx, field)
-			case "correioEletronico":
-				return ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
-			case "situacaoEspecial":
-				return ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
-			case "dataSituacaoEspecial":
-				return ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Estabelecimento", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_estabelecimento_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sociosByCnpjBasico(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_sociosByCnpjBasico(ctx, field)
+func (ec *executionContext) _Empresa_capitalSocial(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_capitalSocial(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3743,7 +3232,7 @@ func (ec *executionContext) _Query_sociosByCnpjBasico(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SociosByCnpjBasico(rctx, fc.Args["cnpjBasico"].(string))
+		return obj.CapitalSocial, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3755,63 +3244,26 @@ func (ec *executionContext) _Query_sociosByCnpjBasico(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*models.Socio)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocioᚄ(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_sociosByCnpjBasico(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_capitalSocial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cnpj":
-				return ec.fieldContext_Socio_cnpj(ctx, field)
-			case "cnpjBasico":
-				return ec.fieldContext_Socio_cnpjBasico(ctx, field)
-			case "identificadorDeSocio":
-				return ec.fieldContext_Socio_identificadorDeSocio(ctx, field)
-			case "nomeSocio":
-				return ec.fieldContext_Socio_nomeSocio(ctx, field)
-			case "cnpjCpfSocio":
-				return ec.fieldContext_Socio_cnpjCpfSocio(ctx, field)
-			case "qualificacaoSocio":
-				return ec.fieldContext_Socio_qualificacaoSocio(ctx, field)
-			case "dataEntradaSociedade":
-				return ec.fieldContext_Socio_dataEntradaSociedade(ctx, field)
-			case "pais":
-				return ec.fieldContext_Socio_pais(ctx, field)
-			case "representanteLegal":
-				return ec.fieldContext_Socio_representanteLegal(ctx, field)
-			case "nomeRepresentante":
-				return ec.fieldContext_Socio_nomeRepresentante(ctx, field)
-			case "qualificacaoRepresentanteLegal":
-				return ec.fieldContext_Socio_qualificacaoRepresentanteLegal(ctx, field)
-			case "faixaEtaria":
-				return ec.fieldContext_Socio_faixaEtaria(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Socio", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_sociosByCnpjBasico_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_cnaeByCodigo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_cnaeByCodigo(ctx, field)
+func (ec *executionContext) _Empresa_naturezaJuridicaRef(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_naturezaJuridicaRef(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3824,7 +3276,7 @@ func (ec *executionContext) _Query_cnaeByCodigo(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CnaeByCodigo(rctx, fc.Args["codigo"].(string))
+		return ec.resolvers.Empresa().NaturezaJuridicaRef(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3833,43 +3285,32 @@ func (ec *executionContext) _Query_cnaeByCodigo(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*models.CNAE)
+	res := resTmp.(*models.Referencia)
 	fc.Result = res
-	return ec.marshalOCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx, field.Selections, res)
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_cnaeByCodigo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_naturezaJuridicaRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "codigo":
-				return ec.fieldContext_CNAE_codigo(ctx, field)
+				return ec.fieldContext_Referencia_codigo(ctx, field)
 			case "descricao":
-				return ec.fieldContext_CNAE_descricao(ctx, field)
+				return ec.fieldContext_Referencia_descricao(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CNAE", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_cnaeByCodigo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_buscarProspeccao(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_buscarProspeccao(ctx, field)
+func (ec *executionContext) _Empresa_qualificacaoResponsavelRef(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_qualificacaoResponsavelRef(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3882,61 +3323,41 @@ func (ec *executionContext) _Query_buscarProspeccao(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BuscarProspeccao(rctx, fc.Args["filter"].(*model.ProspeccaoFilter), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		return ec.resolvers.Empresa().QualificacaoResponsavelRef(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*models.ProspeccaoDetalhada)
+	res := resTmp.(*models.Referencia)
 	fc.Result = res
-	return ec.marshalNProspeccaoDetalhada2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhadaᚄ(ctx, field.Selections, res)
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_buscarProspeccao(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_qualificacaoResponsavelRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "empresa":
-				return ec.fieldContext_ProspeccaoDetalhada_empresa(ctx, field)
-			case "estabelecimento":
-				return ec.fieldContext_ProspeccaoDetalhada_estabelecimento(ctx, field)
-			case "socios":
-				return ec.fieldContext_ProspeccaoDetalhada_socios(ctx, field)
-			case "cnaeFiscal":
-				return ec.fieldContext_ProspeccaoDetalhada_cnaeFiscal(ctx, field)
-			case "cnaeSecundaria":
-				return ec.fieldContext_ProspeccaoDetalhada_cnaeSecundaria(ctx, field)
+			case "codigo":
+				return ec.fieldContext_Referencia_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_Referencia_descricao(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoDetalhada", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_buscarProspeccao_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _Empresa_estabelecimentos(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_estabelecimentos(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3949,70 +3370,120 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return ec.resolvers.Empresa().Estabelecimentos(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*models.Estabelecimento)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNEstabelecimento2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimentoᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_estabelecimentos(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+			case "id":
+				return ec.fieldContext_Estabelecimento_id(ctx, field)
+			case "cnpj":
+				return ec.fieldContext_Estabelecimento_cnpj(ctx, field)
+			case "cnpjFormatado":
+				return ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Estabelecimento_empresa(ctx, field)
+			case "cnpjOrdem":
+				return ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
+			case "cnpjDv":
+				return ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
+			case "matrizFilial":
+				return ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Estabelecimento_tipo(ctx, field)
+			case "nomeFantasia":
+				return ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
+			case "situacaoCadastral":
+				return ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
+			case "situacao":
+				return ec.fieldContext_Estabelecimento_situacao(ctx, field)
+			case "dataSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastralRef":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastralRef(ctx, field)
+			case "nomeCidadeExterior":
+				return ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
+			case "pais":
+				return ec.fieldContext_Estabelecimento_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Estabelecimento_paisRef(ctx, field)
+			case "dataInicioAtividades":
+				return ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
+			case "cnaePrincipal":
+				return ec.fieldContext_Estabelecimento_cnaePrincipal(ctx, field)
+			case "cnaeFiscalSecundaria":
+				return ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
+			case "tipoLogradouro":
+				return ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
+			case "logradouro":
+				return ec.fieldContext_Estabelecimento_logradouro(ctx, field)
+			case "numero":
+				return ec.fieldContext_Estabelecimento_numero(ctx, field)
+			case "complemento":
+				return ec.fieldContext_Estabelecimento_complemento(ctx, field)
+			case "bairro":
+				return ec.fieldContext_Estabelecimento_bairro(ctx, field)
+			case "cep":
+				return ec.fieldContext_Estabelecimento_cep(ctx, field)
+			case "uf":
+				return ec.fieldContext_Estabelecimento_uf(ctx, field)
+			case "municipio":
+				return ec.fieldContext_Estabelecimento_municipio(ctx, field)
+			case "municipioRef":
+				return ec.fieldContext_Estabelecimento_municipioRef(ctx, field)
+			case "ddd1":
+				return ec.fieldContext_Estabelecimento_ddd1(ctx, field)
+			case "telefone1":
+				return ec.fieldContext_Estabelecimento_telefone1(ctx, field)
+			case "ddd2":
+				return ec.fieldContext_Estabelecimento_ddd2(ctx, field)
+			case "telefone2":
+				return ec.fieldContext_Estabelecimento_telefone2(ctx, field)
+			case "dddFax":
+				return ec.fieldContext_Estabelecimento_dddFax(ctx, field)
+			case "fax":
+				return ec.fieldContext_Estabelecimento_fax(ctx, field)
+			case "correioEletronico":
+				return ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
+			case "situacaoEspecial":
+				return ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
+			case "dataSituacaoEspecial":
+				return ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Estabelecimento", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Empresa_matriz(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_matriz(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4025,7 +3496,7 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return ec.resolvers.Empresa().Matriz(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4034,40 +3505,108 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*models.Estabelecimento)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalOEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_matriz(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Empresa",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "id":
+				return ec.fieldContext_Estabelecimento_id(ctx, field)
+			case "cnpj":
+				return ec.fieldContext_Estabelecimento_cnpj(ctx, field)
+			case "cnpjFormatado":
+				return ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Estabelecimento_empresa(ctx, field)
+			case "cnpjOrdem":
+				return ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
+			case "cnpjDv":
+				return ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
+			case "matrizFilial":
+				return ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Estabelecimento_tipo(ctx, field)
+			case "nomeFantasia":
+				return ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
+			case "situacaoCadastral":
+				return ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
+			case "situacao":
+				return ec.fieldContext_Estabelecimento_situacao(ctx, field)
+			case "dataSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastralRef":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastralRef(ctx, field)
+			case "nomeCidadeExterior":
+				return ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
+			case "pais":
+				return ec.fieldContext_Estabelecimento_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Estabelecimento_paisRef(ctx, field)
+			case "dataInicioAtividades":
+				return ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
+			case "cnaePrincipal":
+				return ec.fieldContext_Estabelecimento_cnaePrincipal(ctx, field)
+			case "cnaeFiscalSecundaria":
+				return ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
+			case "tipoLogradouro":
+				return ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
+			case "logradouro":
+				return ec.fieldContext_Estabelecimento_logradouro(ctx, field)
+			case "numero":
+				return ec.fieldContext_Estabelecimento_numero(ctx, field)
+			case "complemento":
+				return ec.fieldContext_Estabelecimento_complemento(ctx, field)
+			case "bairro":
+				return ec.fieldContext_Estabelecimento_bairro(ctx, field)
+			case "cep":
+				return ec.fieldContext_Estabelecimento_cep(ctx, field)
+			case "uf":
+				return ec.fieldContext_Estabelecimento_uf(ctx, field)
+			case "municipio":
+				return ec.fieldContext_Estabelecimento_municipio(ctx, field)
+			case "municipioRef":
+				return ec.fieldContext_Estabelecimento_municipioRef(ctx, field)
+			case "ddd1":
+				return ec.fieldContext_Estabelecimento_ddd1(ctx, field)
+			case "telefone1":
+				return ec.fieldContext_Estabelecimento_telefone1(ctx, field)
+			case "ddd2":
+				return ec.fieldContext_Estabelecimento_ddd2(ctx, field)
+			case "telefone2":
+				return ec.fieldContext_Estabelecimento_telefone2(ctx, field)
+			case "dddFax":
+				return ec.fieldContext_Estabelecimento_dddFax(ctx, field)
+			case "fax":
+				return ec.fieldContext_Estabelecimento_fax(ctx, field)
+			case "correioEletronico":
+				return ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
+			case "situacaoEspecial":
+				return ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
+			case "dataSituacaoEspecial":
+				return ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Estabelecimento", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Simples_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Simples_cnpjBasico(ctx, field)
+func (ec *executionContext) _Empresa_socios(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_socios(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4080,7 +3619,7 @@ func (ec *executionContext) _Simples_cnpjBasico(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJBasico, nil
+		return ec.resolvers.Empresa().Socios(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4092,26 +3631,62 @@ func (ec *executionContext) _Simples_cnpjBasico(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*models.Socio)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocioᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Simples_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_socios(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Simples",
+		Object:     "Empresa",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cnpj":
+				return ec.fieldContext_Socio_cnpj(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Socio_cnpjBasico(ctx, field)
+			case "identificadorDeSocio":
+				return ec.fieldContext_Socio_identificadorDeSocio(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Socio_tipo(ctx, field)
+			case "nomeSocio":
+				return ec.fieldContext_Socio_nomeSocio(ctx, field)
+			case "cnpjCpfSocio":
+				return ec.fieldContext_Socio_cnpjCpfSocio(ctx, field)
+			case "qualificacaoSocio":
+				return ec.fieldContext_Socio_qualificacaoSocio(ctx, field)
+			case "qualificacaoSocioRef":
+				return ec.fieldContext_Socio_qualificacaoSocioRef(ctx, field)
+			case "dataEntradaSociedade":
+				return ec.fieldContext_Socio_dataEntradaSociedade(ctx, field)
+			case "pais":
+				return ec.fieldContext_Socio_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Socio_paisRef(ctx, field)
+			case "representanteLegal":
+				return ec.fieldContext_Socio_representanteLegal(ctx, field)
+			case "nomeRepresentante":
+				return ec.fieldContext_Socio_nomeRepresentante(ctx, field)
+			case "qualificacaoRepresentanteLegal":
+				return ec.fieldContext_Socio_qualificacaoRepresentanteLegal(ctx, field)
+			case "qualificacaoRepresentanteLegalRef":
+				return ec.fieldContext_Socio_qualificacaoRepresentanteLegalRef(ctx, field)
+			case "faixaEtaria":
+				return ec.fieldContext_Socio_faixaEtaria(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Socio_empresa(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Socio", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Simples_opcaoSimples(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Simples_opcaoSimples(ctx, field)
+func (ec *executionContext) _Empresa_simples(ctx context.Context, field graphql.CollectedField, obj *models.Empresa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Empresa_simples(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4124,38 +3699,51 @@ func (ec *executionContext) _Simples_opcaoSimples(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OpcaoSimples, nil
+		return ec.resolvers.Empresa().Simples(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*models.Simples)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOSimples2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSimples(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Simples_opcaoSimples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Empresa_simples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Simples",
+		Object:     "Empresa",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Simples_cnpjBasico(ctx, field)
+			case "opcaoSimples":
+				return ec.fieldContext_Simples_opcaoSimples(ctx, field)
+			case "dataOpcaoSimples":
+				return ec.fieldContext_Simples_dataOpcaoSimples(ctx, field)
+			case "dataExclusaoSimples":
+				return ec.fieldContext_Simples_dataExclusaoSimples(ctx, field)
+			case "opcaoMEI":
+				return ec.fieldContext_Simples_opcaoMEI(ctx, field)
+			case "dataOpcaoMEI":
+				return ec.fieldContext_Simples_dataOpcaoMEI(ctx, field)
+			case "dataExclusaoMEI":
+				return ec.fieldContext_Simples_dataExclusaoMEI(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Simples", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Simples_dataOpcaoSimples(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Simples_dataOpcaoSimples(ctx, field)
+func (ec *executionContext) _Estabelecimento_id(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4168,35 +3756,38 @@ func (ec *executionContext) _Simples_dataOpcaoSimples(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DataOpcaoSimples, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Simples_dataOpcaoSimples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Simples",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Simples_dataExclusaoSimples(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Simples_dataExclusaoSimples(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnpj(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnpj(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4209,23 +3800,26 @@ func (ec *executionContext) _Simples_dataExclusaoSimples(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DataExclusaoSimples, nil
+		return obj.CNPJ, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Simples_dataExclusaoSimples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnpj(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Simples",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4236,8 +3830,8 @@ func (ec *executionContext) fieldContext_Simples_dataExclusaoSimples(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _Simples_opcaoMEI(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Simples_opcaoMEI(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnpjFormatado(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4250,7 +3844,7 @@ func (ec *executionContext) _Simples_opcaoMEI(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OpcaoMEI, nil
+		return obj.CNPJFormatado, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4267,9 +3861,9 @@ func (ec *executionContext) _Simples_opcaoMEI(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Simples_opcaoMEI(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnpjFormatado(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Simples",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4280,8 +3874,8 @@ func (ec *executionContext) fieldContext_Simples_opcaoMEI(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Simples_dataOpcaoMEI(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Simples_dataOpcaoMEI(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4294,23 +3888,26 @@ func (ec *executionContext) _Simples_dataOpcaoMEI(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DataOpcaoMEI, nil
+		return obj.CNPJBasico, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Simples_dataOpcaoMEI(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Simples",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4321,8 +3918,8 @@ func (ec *executionContext) fieldContext_Simples_dataOpcaoMEI(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Simples_dataExclusaoMEI(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Simples_dataExclusaoMEI(ctx, field)
+func (ec *executionContext) _Estabelecimento_empresa(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_empresa(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4335,7 +3932,7 @@ func (ec *executionContext) _Simples_dataExclusaoMEI(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DataExclusaoMEI, nil
+		return ec.resolvers.Estabelecimento().Empresa(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4344,26 +3941,56 @@ func (ec *executionContext) _Simples_dataExclusaoMEI(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*models.Empresa)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Simples_dataExclusaoMEI(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_empresa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Simples",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
+			case "razaoSocial":
+				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
+			case "naturezaJuridica":
+				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
+			case "qualificacaoResponsavel":
+				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
+			case "porteEmpresa":
+				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
+			case "porte":
+				return ec.fieldContext_Empresa_porte(ctx, field)
+			case "enteFederativoResponsavel":
+				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
+			case "capitalSocial":
+				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
+			case "naturezaJuridicaRef":
+				return ec.fieldContext_Empresa_naturezaJuridicaRef(ctx, field)
+			case "qualificacaoResponsavelRef":
+				return ec.fieldContext_Empresa_qualificacaoResponsavelRef(ctx, field)
+			case "estabelecimentos":
+				return ec.fieldContext_Empresa_estabelecimentos(ctx, field)
+			case "matriz":
+				return ec.fieldContext_Empresa_matriz(ctx, field)
+			case "socios":
+				return ec.fieldContext_Empresa_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_Empresa_simples(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_cnpj(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_cnpj(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnpjOrdem(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4376,7 +4003,7 @@ func (ec *executionContext) _Socio_cnpj(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJ, nil
+		return obj.CNPJOrdem, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4393,9 +4020,9 @@ func (ec *executionContext) _Socio_cnpj(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_cnpj(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnpjOrdem(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4406,8 +4033,8 @@ func (ec *executionContext) fieldContext_Socio_cnpj(_ context.Context, field gra
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_cnpjBasico(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnpjDv(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4420,7 +4047,7 @@ func (ec *executionContext) _Socio_cnpjBasico(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJBasico, nil
+		return obj.CNPJDV, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4437,9 +4064,9 @@ func (ec *executionContext) _Socio_cnpjBasico(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnpjDv(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4450,8 +4077,8 @@ func (ec *executionContext) fieldContext_Socio_cnpjBasico(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_identificadorDeSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_identificadorDeSocio(ctx, field)
+func (ec *executionContext) _Estabelecimento_matrizFilial(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4464,7 +4091,7 @@ func (ec *executionContext) _Socio_identificadorDeSocio(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IdentificadorDeSocio, nil
+		return obj.MatrizFilial, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4481,9 +4108,9 @@ func (ec *executionContext) _Socio_identificadorDeSocio(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_identificadorDeSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_matrizFilial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4494,8 +4121,8 @@ func (ec *executionContext) fieldContext_Socio_identificadorDeSocio(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_nomeSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_nomeSocio(ctx, field)
+func (ec *executionContext) _Estabelecimento_tipo(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_tipo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4508,38 +4135,35 @@ func (ec *executionContext) _Socio_nomeSocio(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NomeSocio, nil
+		return ec.resolvers.Estabelecimento().Tipo(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.TipoEstabelecimento)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTipoEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoEstabelecimento(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_nomeSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_tipo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type TipoEstabelecimento does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_cnpjCpfSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_cnpjCpfSocio(ctx, field)
+func (ec *executionContext) _Estabelecimento_nomeFantasia(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4552,26 +4176,23 @@ func (ec *executionContext) _Socio_cnpjCpfSocio(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CNPJCPFSocio, nil
+		return obj.NomeFantasia, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_cnpjCpfSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_nomeFantasia(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4582,8 +4203,8 @@ func (ec *executionContext) fieldContext_Socio_cnpjCpfSocio(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_qualificacaoSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_qualificacaoSocio(ctx, field)
+func (ec *executionContext) _Estabelecimento_situacaoCadastral(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4596,7 +4217,7 @@ func (ec *executionContext) _Socio_qualificacaoSocio(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QualificacaoSocio, nil
+		return obj.SituacaoCadastral, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4613,9 +4234,9 @@ func (ec *executionContext) _Socio_qualificacaoSocio(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_qualificacaoSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_situacaoCadastral(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4626,8 +4247,8 @@ func (ec *executionContext) fieldContext_Socio_qualificacaoSocio(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_dataEntradaSociedade(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_dataEntradaSociedade(ctx, field)
+func (ec *executionContext) _Estabelecimento_situacao(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_situacao(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4640,38 +4261,35 @@ func (ec *executionContext) _Socio_dataEntradaSociedade(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DataEntradaSociedade, nil
+		return ec.resolvers.Estabelecimento().Situacao(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.SituacaoCadastral)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOSituacaoCadastral2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_dataEntradaSociedade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_situacao(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type SituacaoCadastral does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_pais(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_pais(ctx, field)
+func (ec *executionContext) _Estabelecimento_dataSituacaoCadastral(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4684,7 +4302,7 @@ func (ec *executionContext) _Socio_pais(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pais, nil
+		return obj.DataSituacaoCadastral, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4693,26 +4311,26 @@ func (ec *executionContext) _Socio_pais(ctx context.Context, field graphql.Colle
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(models.Date)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_pais(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_dataSituacaoCadastral(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_representanteLegal(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_representanteLegal(ctx, field)
+func (ec *executionContext) _Estabelecimento_motivoSituacaoCadastral(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4725,7 +4343,7 @@ func (ec *executionContext) _Socio_representanteLegal(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RepresentanteLegal, nil
+		return obj.MotivoSituacaoCadastral, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4734,14 +4352,14 @@ func (ec *executionContext) _Socio_representanteLegal(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_representanteLegal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_motivoSituacaoCadastral(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4752,8 +4370,8 @@ func (ec *executionContext) fieldContext_Socio_representanteLegal(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_nomeRepresentante(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_nomeRepresentante(ctx, field)
+func (ec *executionContext) _Estabelecimento_motivoSituacaoCadastralRef(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_motivoSituacaoCadastralRef(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4766,7 +4384,7 @@ func (ec *executionContext) _Socio_nomeRepresentante(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NomeRepresentante, nil
+		return ec.resolvers.Estabelecimento().MotivoSituacaoCadastralRef(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4775,26 +4393,32 @@ func (ec *executionContext) _Socio_nomeRepresentante(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*models.Referencia)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_nomeRepresentante(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_motivoSituacaoCadastralRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_Referencia_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_Referencia_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_qualificacaoRepresentanteLegal(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_qualificacaoRepresentanteLegal(ctx, field)
+func (ec *executionContext) _Estabelecimento_nomeCidadeExterior(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4807,7 +4431,7 @@ func (ec *executionContext) _Socio_qualificacaoRepresentanteLegal(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QualificacaoRepresentanteLegal, nil
+		return obj.NomeCidadeExterior, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4816,14 +4440,14 @@ func (ec *executionContext) _Socio_qualificacaoRepresentanteLegal(ctx context.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_qualificacaoRepresentanteLegal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_nomeCidadeExterior(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4834,8 +4458,8 @@ func (ec *executionContext) fieldContext_Socio_qualificacaoRepresentanteLegal(_
 	return fc, nil
 }
 
-func (ec *executionContext) _Socio_faixaEtaria(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Socio_faixaEtaria(ctx, field)
+func (ec *executionContext) _Estabelecimento_pais(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_pais(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4848,7 +4472,7 @@ func (ec *executionContext) _Socio_faixaEtaria(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FaixaEtaria, nil
+		return obj.Pais, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4857,14 +4481,14 @@ func (ec *executionContext) _Socio_faixaEtaria(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Socio_faixaEtaria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_pais(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Socio",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4875,8 +4499,8 @@ func (ec *executionContext) fieldContext_Socio_faixaEtaria(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _Estabelecimento_paisRef(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_paisRef(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4889,38 +4513,41 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Estabelecimento().PaisRef(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*models.Referencia)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_paisRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_Referencia_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_Referencia_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _Estabelecimento_dataInicioAtividades(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4933,7 +4560,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.DataInicioAtividades, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4942,26 +4569,26 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(models.Date)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_dataInicioAtividades(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnaeFiscal(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4974,7 +4601,7 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.CNAEFiscal, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4986,26 +4613,26 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnaeFiscal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnaePrincipal(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnaePrincipal(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5018,38 +4645,41 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return ec.resolvers.Estabelecimento().CnaePrincipal(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*models.CNAE)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnaePrincipal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_CNAE_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_CNAE_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CNAE", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _Estabelecimento_cnaeFiscalSecundaria(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5062,63 +4692,35 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.CNAEFiscalSecundaria, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cnaeFiscalSecundaria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _Estabelecimento_tipoLogradouro(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5131,7 +4733,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.TipoLogradouro, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5148,9 +4750,9 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_tipoLogradouro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5161,8 +4763,8 @@ func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _Estabelecimento_logradouro(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_logradouro(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5175,25 +4777,28 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Logradouro, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_logradouro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5202,8 +4807,8 @@ func (ec *executionContext) fieldContext___EnumValue_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+func (ec *executionContext) _Estabelecimento_numero(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_numero(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5216,7 +4821,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Numero, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5228,26 +4833,26 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_numero(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _Estabelecimento_complemento(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_complemento(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5260,7 +4865,7 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Complemento, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5274,11 +4879,11 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_complemento(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5287,8 +4892,8 @@ func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _Estabelecimento_bairro(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_bairro(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5301,26 +4906,23 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Bairro, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_bairro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5331,8 +4933,8 @@ func (ec *executionContext) fieldContext___Field_name(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _Estabelecimento_cep(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_cep(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5345,25 +4947,28 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.CEP, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_cep(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5372,8 +4977,8 @@ func (ec *executionContext) fieldContext___Field_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _Estabelecimento_uf(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_uf(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5386,7 +4991,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.UF, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5398,51 +5003,26 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_uf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _Estabelecimento_municipio(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_municipio(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5455,7 +5035,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Municipio, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5467,50 +5047,26 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_municipio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _Estabelecimento_municipioRef(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_municipioRef(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5523,38 +5079,41 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Estabelecimento().MunicipioRef(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*models.Referencia)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_municipioRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_Referencia_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_Referencia_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _Estabelecimento_ddd1(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_ddd1(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5567,7 +5126,7 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.DDD1, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5581,11 +5140,11 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_ddd1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5594,8 +5153,8 @@ func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _Estabelecimento_telefone1(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_telefone1(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5608,26 +5167,23 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Telefone1, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_telefone1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5638,8 +5194,8 @@ func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _Estabelecimento_ddd2(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_ddd2(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5652,7 +5208,7 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.DDD2, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5666,11 +5222,11 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_ddd2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5679,8 +5235,8 @@ func (ec *executionContext) fieldContext___InputValue_description(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _Estabelecimento_telefone2(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_telefone2(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5693,62 +5249,35 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Telefone2, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_telefone2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _Estabelecimento_dddFax(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_dddFax(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5761,7 +5290,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.DDDFax, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5775,9 +5304,9 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_dddFax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Estabelecimento",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5788,8 +5317,8 @@ func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_isDeprecated(ctx, field)
+func (ec *executionContext) _Estabelecimento_fax(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_fax(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5802,38 +5331,35 @@ func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Fax, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_fax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_deprecationReason(ctx, field)
+func (ec *executionContext) _Estabelecimento_correioEletronico(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5846,7 +5372,7 @@ func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.CorreioEletronico, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5860,11 +5386,11 @@ func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context,
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_correioEletronico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5873,8 +5399,8 @@ func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _Estabelecimento_situacaoEspecial(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5887,7 +5413,7 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.SituacaoEspecial, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5901,11 +5427,11 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_situacaoEspecial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -5914,8 +5440,8 @@ func (ec *executionContext) fieldContext___Schema_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _Estabelecimento_dataSituacaoEspecial(ctx context.Context, field graphql.CollectedField, obj *models.Estabelecimento) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5928,62 +5454,35 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.DataSituacaoEspecial, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(models.Date)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Estabelecimento_dataSituacaoEspecial(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Estabelecimento",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _Mudanca_id(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5996,7 +5495,7 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6008,50 +5507,26 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _Mudanca_tipo(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_tipo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6064,59 +5539,38 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return ec.resolvers.Mudanca().Tipo(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model.TipoMudanca)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNTipoMudanca2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudanca(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_tipo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Mudanca",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type TipoMudanca does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _Mudanca_cnpj(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_cnpj(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6129,59 +5583,38 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.CNPJ, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_cnpj(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _Mudanca_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_cnpjBasico(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6194,7 +5627,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.CNPJBasico, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6206,38 +5639,26 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _Mudanca_uf(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_uf(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6250,38 +5671,35 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.UF, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_uf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _Mudanca_cnaeFiscal(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_cnaeFiscal(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6294,7 +5712,7 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.CNAEFiscal, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6308,11 +5726,11 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_cnaeFiscal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6321,8 +5739,8 @@ func (ec *executionContext) fieldContext___Type_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _Mudanca_valorAnterior(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_valorAnterior(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6335,7 +5753,7 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.ValorAnterior, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6349,11 +5767,11 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_valorAnterior(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6362,8 +5780,8 @@ func (ec *executionContext) fieldContext___Type_description(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+func (ec *executionContext) _Mudanca_valorNovo(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_valorNovo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6376,7 +5794,7 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
+		return obj.ValorNovo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6390,11 +5808,11 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_valorNovo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -6403,8 +5821,8 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _Mudanca_detectadaEm(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_detectadaEm(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6417,60 +5835,38 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.DetectadaEm, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(models.Date)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNDate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_detectadaEm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _Mudanca_referencia(ctx context.Context, field graphql.CollectedField, obj *models.Mudanca) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mudanca_referencia(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6483,7 +5879,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.Referencia, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6492,50 +5888,26 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mudanca_referencia(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mudanca",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *models.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6548,59 +5920,38 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *models.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6613,56 +5964,79 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *models.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_startCursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartCursor, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_startCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *models.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6675,7 +6049,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6684,40 +6058,26 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+func (ec *executionContext) _ProgressoImportacao_referencia(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_referencia(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6730,59 +6090,38 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.Referencia, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProgressoImportacao_referencia(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ProgressoImportacao",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_isOneOf(ctx, field)
+func (ec *executionContext) _ProgressoImportacao_fase(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_fase(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6795,195 +6134,8793 @@ func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsOneOf(), nil
+		return ec.resolvers.ProgressoImportacao().Fase(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.FaseImportacao)
 	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFaseImportacao2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFaseImportacao(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProgressoImportacao_fase(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ProgressoImportacao",
 		Field:      field,
 		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FaseImportacao does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProgressoImportacao_tabela(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_tabela(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tabela, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProgressoImportacao_tabela(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProgressoImportacao",
+		Field:      field,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
+func (ec *executionContext) _ProgressoImportacao_arquivo(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_arquivo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Arquivo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
 
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) fieldContext_ProgressoImportacao_arquivo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProgressoImportacao",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-func (ec *executionContext) unmarshalInputProspeccaoFilter(ctx context.Context, obj any) (model.ProspeccaoFilter, error) {
-	var it model.ProspeccaoFilter
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+func (ec *executionContext) _ProgressoImportacao_linhasProcessadas(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_linhasProcessadas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LinhasProcessadas, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
 
-	fieldsInOrder := [...]string{"cnpj", "razaoSocial", "nomeFantasia", "uf", "municipio", "situacaoCadastral", "dataSituacaoCadastralMin", "dataSituacaoCadastralMax", "porteEmpresa", "naturezaJuridica", "cnaeFiscal", "cnaeFiscalSecundaria", "minCapitalSocial", "maxCapitalSocial", "dataInicioAtividadesMin", "dataInicioAtividadesMax"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
+func (ec *executionContext) fieldContext_ProgressoImportacao_linhasProcessadas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProgressoImportacao",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProgressoImportacao_rejeitadas(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_rejeitadas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		switch k {
-		case "cnpj":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpj"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Cnpj = data
-		case "razaoSocial":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("razaoSocial"))
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rejeitadas, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProgressoImportacao_rejeitadas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProgressoImportacao",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProgressoImportacao_etaSegundos(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_etaSegundos(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EtaSegundos, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProgressoImportacao_etaSegundos(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProgressoImportacao",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProgressoImportacao_mensagem(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_mensagem(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mensagem, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProgressoImportacao_mensagem(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProgressoImportacao",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProgressoImportacao_em(ctx context.Context, field graphql.CollectedField, obj *models.ProgressoImportacao) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProgressoImportacao_em(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Em, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProgressoImportacao_em(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProgressoImportacao",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoConnection_edges(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoConnection_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.ProspeccaoEdge)
+	fc.Result = res
+	return ec.marshalNProspeccaoEdge2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_ProspeccaoEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_ProspeccaoEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoConnection_totalCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ProspeccaoConnection().TotalCount(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoConnection",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoDetalhada_empresa(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoDetalhada_empresa(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Empresa, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Empresa)
+	fc.Result = res
+	return ec.marshalNEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoDetalhada_empresa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoDetalhada",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
+			case "razaoSocial":
+				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
+			case "naturezaJuridica":
+				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
+			case "qualificacaoResponsavel":
+				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
+			case "porteEmpresa":
+				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
+			case "porte":
+				return ec.fieldContext_Empresa_porte(ctx, field)
+			case "enteFederativoResponsavel":
+				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
+			case "capitalSocial":
+				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
+			case "naturezaJuridicaRef":
+				return ec.fieldContext_Empresa_naturezaJuridicaRef(ctx, field)
+			case "qualificacaoResponsavelRef":
+				return ec.fieldContext_Empresa_qualificacaoResponsavelRef(ctx, field)
+			case "estabelecimentos":
+				return ec.fieldContext_Empresa_estabelecimentos(ctx, field)
+			case "matriz":
+				return ec.fieldContext_Empresa_matriz(ctx, field)
+			case "socios":
+				return ec.fieldContext_Empresa_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_Empresa_simples(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoDetalhada_estabelecimento(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoDetalhada_estabelecimento(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Estabelecimento, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Estabelecimento)
+	fc.Result = res
+	return ec.marshalNEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoDetalhada_estabelecimento(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoDetalhada",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Estabelecimento_id(ctx, field)
+			case "cnpj":
+				return ec.fieldContext_Estabelecimento_cnpj(ctx, field)
+			case "cnpjFormatado":
+				return ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Estabelecimento_empresa(ctx, field)
+			case "cnpjOrdem":
+				return ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
+			case "cnpjDv":
+				return ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
+			case "matrizFilial":
+				return ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Estabelecimento_tipo(ctx, field)
+			case "nomeFantasia":
+				return ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
+			case "situacaoCadastral":
+				return ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
+			case "situacao":
+				return ec.fieldContext_Estabelecimento_situacao(ctx, field)
+			case "dataSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastralRef":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastralRef(ctx, field)
+			case "nomeCidadeExterior":
+				return ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
+			case "pais":
+				return ec.fieldContext_Estabelecimento_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Estabelecimento_paisRef(ctx, field)
+			case "dataInicioAtividades":
+				return ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
+			case "cnaePrincipal":
+				return ec.fieldContext_Estabelecimento_cnaePrincipal(ctx, field)
+			case "cnaeFiscalSecundaria":
+				return ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
+			case "tipoLogradouro":
+				return ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
+			case "logradouro":
+				return ec.fieldContext_Estabelecimento_logradouro(ctx, field)
+			case "numero":
+				return ec.fieldContext_Estabelecimento_numero(ctx, field)
+			case "complemento":
+				return ec.fieldContext_Estabelecimento_complemento(ctx, field)
+			case "bairro":
+				return ec.fieldContext_Estabelecimento_bairro(ctx, field)
+			case "cep":
+				return ec.fieldContext_Estabelecimento_cep(ctx, field)
+			case "uf":
+				return ec.fieldContext_Estabelecimento_uf(ctx, field)
+			case "municipio":
+				return ec.fieldContext_Estabelecimento_municipio(ctx, field)
+			case "municipioRef":
+				return ec.fieldContext_Estabelecimento_municipioRef(ctx, field)
+			case "ddd1":
+				return ec.fieldContext_Estabelecimento_ddd1(ctx, field)
+			case "telefone1":
+				return ec.fieldContext_Estabelecimento_telefone1(ctx, field)
+			case "ddd2":
+				return ec.fieldContext_Estabelecimento_ddd2(ctx, field)
+			case "telefone2":
+				return ec.fieldContext_Estabelecimento_telefone2(ctx, field)
+			case "dddFax":
+				return ec.fieldContext_Estabelecimento_dddFax(ctx, field)
+			case "fax":
+				return ec.fieldContext_Estabelecimento_fax(ctx, field)
+			case "correioEletronico":
+				return ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
+			case "situacaoEspecial":
+				return ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
+			case "dataSituacaoEspecial":
+				return ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Estabelecimento", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoDetalhada_socios(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoDetalhada_socios(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Socios, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Socio)
+	fc.Result = res
+	return ec.marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocioᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoDetalhada_socios(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoDetalhada",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpj":
+				return ec.fieldContext_Socio_cnpj(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Socio_cnpjBasico(ctx, field)
+			case "identificadorDeSocio":
+				return ec.fieldContext_Socio_identificadorDeSocio(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Socio_tipo(ctx, field)
+			case "nomeSocio":
+				return ec.fieldContext_Socio_nomeSocio(ctx, field)
+			case "cnpjCpfSocio":
+				return ec.fieldContext_Socio_cnpjCpfSocio(ctx, field)
+			case "qualificacaoSocio":
+				return ec.fieldContext_Socio_qualificacaoSocio(ctx, field)
+			case "qualificacaoSocioRef":
+				return ec.fieldContext_Socio_qualificacaoSocioRef(ctx, field)
+			case "dataEntradaSociedade":
+				return ec.fieldContext_Socio_dataEntradaSociedade(ctx, field)
+			case "pais":
+				return ec.fieldContext_Socio_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Socio_paisRef(ctx, field)
+			case "representanteLegal":
+				return ec.fieldContext_Socio_representanteLegal(ctx, field)
+			case "nomeRepresentante":
+				return ec.fieldContext_Socio_nomeRepresentante(ctx, field)
+			case "qualificacaoRepresentanteLegal":
+				return ec.fieldContext_Socio_qualificacaoRepresentanteLegal(ctx, field)
+			case "qualificacaoRepresentanteLegalRef":
+				return ec.fieldContext_Socio_qualificacaoRepresentanteLegalRef(ctx, field)
+			case "faixaEtaria":
+				return ec.fieldContext_Socio_faixaEtaria(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Socio_empresa(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Socio", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoDetalhada_simples(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoDetalhada_simples(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Simples, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Simples)
+	fc.Result = res
+	return ec.marshalOSimples2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSimples(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoDetalhada_simples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoDetalhada",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Simples_cnpjBasico(ctx, field)
+			case "opcaoSimples":
+				return ec.fieldContext_Simples_opcaoSimples(ctx, field)
+			case "dataOpcaoSimples":
+				return ec.fieldContext_Simples_dataOpcaoSimples(ctx, field)
+			case "dataExclusaoSimples":
+				return ec.fieldContext_Simples_dataExclusaoSimples(ctx, field)
+			case "opcaoMEI":
+				return ec.fieldContext_Simples_opcaoMEI(ctx, field)
+			case "dataOpcaoMEI":
+				return ec.fieldContext_Simples_dataOpcaoMEI(ctx, field)
+			case "dataExclusaoMEI":
+				return ec.fieldContext_Simples_dataExclusaoMEI(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Simples", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoDetalhada_cnaeFiscal(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoDetalhada_cnaeFiscal(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CNAEFiscal, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.CNAE)
+	fc.Result = res
+	return ec.marshalOCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoDetalhada_cnaeFiscal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoDetalhada",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_CNAE_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_CNAE_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CNAE", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoDetalhada_cnaeSecundaria(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoDetalhada_cnaeSecundaria(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CNAESecundaria, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.CNAE)
+	fc.Result = res
+	return ec.marshalNCNAE2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAEᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoDetalhada_cnaeSecundaria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoDetalhada",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_CNAE_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_CNAE_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CNAE", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoDetalhada_score(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoDetalhada) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoDetalhada_score(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Score, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoDetalhada_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoDetalhada",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoEdge_node(ctx context.Context, field graphql.CollectedField, obj *models.ProspeccaoEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.ProspeccaoDetalhada)
+	fc.Result = res
+	return ec.marshalNProspeccaoDetalhada2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhada(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "empresa":
+				return ec.fieldContext_ProspeccaoDetalhada_empresa(ctx, field)
+			case "estabelecimento":
+				return ec.fieldContext_ProspeccaoDetalhada_estabelecimento(ctx, field)
+			case "socios":
+				return ec.fieldContext_ProspeccaoDetalhada_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_ProspeccaoDetalhada_simples(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeFiscal(ctx, field)
+			case "cnaeSecundaria":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeSecundaria(ctx, field)
+			case "score":
+				return ec.fieldContext_ProspeccaoDetalhada_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoDetalhada", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoLoteItem_entrada(ctx context.Context, field graphql.CollectedField, obj *model.ProspeccaoLoteItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoLoteItem_entrada(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Entrada, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoLoteItem_entrada(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoLoteItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoLoteItem_cnpj(ctx context.Context, field graphql.CollectedField, obj *model.ProspeccaoLoteItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoLoteItem_cnpj(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cnpj, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoLoteItem_cnpj(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoLoteItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoLoteItem_encontrado(ctx context.Context, field graphql.CollectedField, obj *model.ProspeccaoLoteItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoLoteItem_encontrado(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Encontrado, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoLoteItem_encontrado(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoLoteItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoLoteItem_erro(ctx context.Context, field graphql.CollectedField, obj *model.ProspeccaoLoteItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoLoteItem_erro(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Erro, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoLoteItem_erro(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoLoteItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProspeccaoLoteItem_prospeccao(ctx context.Context, field graphql.CollectedField, obj *model.ProspeccaoLoteItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProspeccaoLoteItem_prospeccao(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Prospeccao, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.ProspeccaoDetalhada)
+	fc.Result = res
+	return ec.marshalOProspeccaoDetalhada2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhada(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ProspeccaoLoteItem_prospeccao(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProspeccaoLoteItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "empresa":
+				return ec.fieldContext_ProspeccaoDetalhada_empresa(ctx, field)
+			case "estabelecimento":
+				return ec.fieldContext_ProspeccaoDetalhada_estabelecimento(ctx, field)
+			case "socios":
+				return ec.fieldContext_ProspeccaoDetalhada_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_ProspeccaoDetalhada_simples(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeFiscal(ctx, field)
+			case "cnaeSecundaria":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeSecundaria(ctx, field)
+			case "score":
+				return ec.fieldContext_ProspeccaoDetalhada_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoDetalhada", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_empresas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_empresas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Empresas(rctx, fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Empresa)
+	fc.Result = res
+	return ec.marshalNEmpresa2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresaᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_empresas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
+			case "razaoSocial":
+				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
+			case "naturezaJuridica":
+				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
+			case "qualificacaoResponsavel":
+				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
+			case "porteEmpresa":
+				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
+			case "porte":
+				return ec.fieldContext_Empresa_porte(ctx, field)
+			case "enteFederativoResponsavel":
+				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
+			case "capitalSocial":
+				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
+			case "naturezaJuridicaRef":
+				return ec.fieldContext_Empresa_naturezaJuridicaRef(ctx, field)
+			case "qualificacaoResponsavelRef":
+				return ec.fieldContext_Empresa_qualificacaoResponsavelRef(ctx, field)
+			case "estabelecimentos":
+				return ec.fieldContext_Empresa_estabelecimentos(ctx, field)
+			case "matriz":
+				return ec.fieldContext_Empresa_matriz(ctx, field)
+			case "socios":
+				return ec.fieldContext_Empresa_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_Empresa_simples(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_empresas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_empresa(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_empresa(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Empresa(rctx, fc.Args["cnpjBasico"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Empresa)
+	fc.Result = res
+	return ec.marshalOEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_empresa(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
+			case "razaoSocial":
+				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
+			case "naturezaJuridica":
+				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
+			case "qualificacaoResponsavel":
+				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
+			case "porteEmpresa":
+				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
+			case "porte":
+				return ec.fieldContext_Empresa_porte(ctx, field)
+			case "enteFederativoResponsavel":
+				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
+			case "capitalSocial":
+				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
+			case "naturezaJuridicaRef":
+				return ec.fieldContext_Empresa_naturezaJuridicaRef(ctx, field)
+			case "qualificacaoResponsavelRef":
+				return ec.fieldContext_Empresa_qualificacaoResponsavelRef(ctx, field)
+			case "estabelecimentos":
+				return ec.fieldContext_Empresa_estabelecimentos(ctx, field)
+			case "matriz":
+				return ec.fieldContext_Empresa_matriz(ctx, field)
+			case "socios":
+				return ec.fieldContext_Empresa_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_Empresa_simples(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_empresa_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_estabelecimento(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_estabelecimento(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Estabelecimento(rctx, fc.Args["id"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Estabelecimento)
+	fc.Result = res
+	return ec.marshalOEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_estabelecimento(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Estabelecimento_id(ctx, field)
+			case "cnpj":
+				return ec.fieldContext_Estabelecimento_cnpj(ctx, field)
+			case "cnpjFormatado":
+				return ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Estabelecimento_empresa(ctx, field)
+			case "cnpjOrdem":
+				return ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
+			case "cnpjDv":
+				return ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
+			case "matrizFilial":
+				return ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Estabelecimento_tipo(ctx, field)
+			case "nomeFantasia":
+				return ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
+			case "situacaoCadastral":
+				return ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
+			case "situacao":
+				return ec.fieldContext_Estabelecimento_situacao(ctx, field)
+			case "dataSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastralRef":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastralRef(ctx, field)
+			case "nomeCidadeExterior":
+				return ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
+			case "pais":
+				return ec.fieldContext_Estabelecimento_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Estabelecimento_paisRef(ctx, field)
+			case "dataInicioAtividades":
+				return ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
+			case "cnaePrincipal":
+				return ec.fieldContext_Estabelecimento_cnaePrincipal(ctx, field)
+			case "cnaeFiscalSecundaria":
+				return ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
+			case "tipoLogradouro":
+				return ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
+			case "logradouro":
+				return ec.fieldContext_Estabelecimento_logradouro(ctx, field)
+			case "numero":
+				return ec.fieldContext_Estabelecimento_numero(ctx, field)
+			case "complemento":
+				return ec.fieldContext_Estabelecimento_complemento(ctx, field)
+			case "bairro":
+				return ec.fieldContext_Estabelecimento_bairro(ctx, field)
+			case "cep":
+				return ec.fieldContext_Estabelecimento_cep(ctx, field)
+			case "uf":
+				return ec.fieldContext_Estabelecimento_uf(ctx, field)
+			case "municipio":
+				return ec.fieldContext_Estabelecimento_municipio(ctx, field)
+			case "municipioRef":
+				return ec.fieldContext_Estabelecimento_municipioRef(ctx, field)
+			case "ddd1":
+				return ec.fieldContext_Estabelecimento_ddd1(ctx, field)
+			case "telefone1":
+				return ec.fieldContext_Estabelecimento_telefone1(ctx, field)
+			case "ddd2":
+				return ec.fieldContext_Estabelecimento_ddd2(ctx, field)
+			case "telefone2":
+				return ec.fieldContext_Estabelecimento_telefone2(ctx, field)
+			case "dddFax":
+				return ec.fieldContext_Estabelecimento_dddFax(ctx, field)
+			case "fax":
+				return ec.fieldContext_Estabelecimento_fax(ctx, field)
+			case "correioEletronico":
+				return ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
+			case "situacaoEspecial":
+				return ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
+			case "dataSituacaoEspecial":
+				return ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Estabelecimento", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_estabelecimento_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_estabelecimentoPorCnpj(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_estabelecimentoPorCnpj(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().EstabelecimentoPorCnpj(rctx, fc.Args["cnpj"].(models.CNPJ))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Estabelecimento)
+	fc.Result = res
+	return ec.marshalOEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_estabelecimentoPorCnpj(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Estabelecimento_id(ctx, field)
+			case "cnpj":
+				return ec.fieldContext_Estabelecimento_cnpj(ctx, field)
+			case "cnpjFormatado":
+				return ec.fieldContext_Estabelecimento_cnpjFormatado(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Estabelecimento_cnpjBasico(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Estabelecimento_empresa(ctx, field)
+			case "cnpjOrdem":
+				return ec.fieldContext_Estabelecimento_cnpjOrdem(ctx, field)
+			case "cnpjDv":
+				return ec.fieldContext_Estabelecimento_cnpjDv(ctx, field)
+			case "matrizFilial":
+				return ec.fieldContext_Estabelecimento_matrizFilial(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Estabelecimento_tipo(ctx, field)
+			case "nomeFantasia":
+				return ec.fieldContext_Estabelecimento_nomeFantasia(ctx, field)
+			case "situacaoCadastral":
+				return ec.fieldContext_Estabelecimento_situacaoCadastral(ctx, field)
+			case "situacao":
+				return ec.fieldContext_Estabelecimento_situacao(ctx, field)
+			case "dataSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_dataSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastral":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastral(ctx, field)
+			case "motivoSituacaoCadastralRef":
+				return ec.fieldContext_Estabelecimento_motivoSituacaoCadastralRef(ctx, field)
+			case "nomeCidadeExterior":
+				return ec.fieldContext_Estabelecimento_nomeCidadeExterior(ctx, field)
+			case "pais":
+				return ec.fieldContext_Estabelecimento_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Estabelecimento_paisRef(ctx, field)
+			case "dataInicioAtividades":
+				return ec.fieldContext_Estabelecimento_dataInicioAtividades(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_Estabelecimento_cnaeFiscal(ctx, field)
+			case "cnaePrincipal":
+				return ec.fieldContext_Estabelecimento_cnaePrincipal(ctx, field)
+			case "cnaeFiscalSecundaria":
+				return ec.fieldContext_Estabelecimento_cnaeFiscalSecundaria(ctx, field)
+			case "tipoLogradouro":
+				return ec.fieldContext_Estabelecimento_tipoLogradouro(ctx, field)
+			case "logradouro":
+				return ec.fieldContext_Estabelecimento_logradouro(ctx, field)
+			case "numero":
+				return ec.fieldContext_Estabelecimento_numero(ctx, field)
+			case "complemento":
+				return ec.fieldContext_Estabelecimento_complemento(ctx, field)
+			case "bairro":
+				return ec.fieldContext_Estabelecimento_bairro(ctx, field)
+			case "cep":
+				return ec.fieldContext_Estabelecimento_cep(ctx, field)
+			case "uf":
+				return ec.fieldContext_Estabelecimento_uf(ctx, field)
+			case "municipio":
+				return ec.fieldContext_Estabelecimento_municipio(ctx, field)
+			case "municipioRef":
+				return ec.fieldContext_Estabelecimento_municipioRef(ctx, field)
+			case "ddd1":
+				return ec.fieldContext_Estabelecimento_ddd1(ctx, field)
+			case "telefone1":
+				return ec.fieldContext_Estabelecimento_telefone1(ctx, field)
+			case "ddd2":
+				return ec.fieldContext_Estabelecimento_ddd2(ctx, field)
+			case "telefone2":
+				return ec.fieldContext_Estabelecimento_telefone2(ctx, field)
+			case "dddFax":
+				return ec.fieldContext_Estabelecimento_dddFax(ctx, field)
+			case "fax":
+				return ec.fieldContext_Estabelecimento_fax(ctx, field)
+			case "correioEletronico":
+				return ec.fieldContext_Estabelecimento_correioEletronico(ctx, field)
+			case "situacaoEspecial":
+				return ec.fieldContext_Estabelecimento_situacaoEspecial(ctx, field)
+			case "dataSituacaoEspecial":
+				return ec.fieldContext_Estabelecimento_dataSituacaoEspecial(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Estabelecimento", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_estabelecimentoPorCnpj_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sociosByCnpjBasico(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sociosByCnpjBasico(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SociosByCnpjBasico(rctx, fc.Args["cnpjBasico"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Socio)
+	fc.Result = res
+	return ec.marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocioᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sociosByCnpjBasico(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpj":
+				return ec.fieldContext_Socio_cnpj(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Socio_cnpjBasico(ctx, field)
+			case "identificadorDeSocio":
+				return ec.fieldContext_Socio_identificadorDeSocio(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Socio_tipo(ctx, field)
+			case "nomeSocio":
+				return ec.fieldContext_Socio_nomeSocio(ctx, field)
+			case "cnpjCpfSocio":
+				return ec.fieldContext_Socio_cnpjCpfSocio(ctx, field)
+			case "qualificacaoSocio":
+				return ec.fieldContext_Socio_qualificacaoSocio(ctx, field)
+			case "qualificacaoSocioRef":
+				return ec.fieldContext_Socio_qualificacaoSocioRef(ctx, field)
+			case "dataEntradaSociedade":
+				return ec.fieldContext_Socio_dataEntradaSociedade(ctx, field)
+			case "pais":
+				return ec.fieldContext_Socio_pais(ctx, field)
+			case "paisRef":
+				return ec.fieldContext_Socio_paisRef(ctx, field)
+			case "representanteLegal":
+				return ec.fieldContext_Socio_representanteLegal(ctx, field)
+			case "nomeRepresentante":
+				return ec.fieldContext_Socio_nomeRepresentante(ctx, field)
+			case "qualificacaoRepresentanteLegal":
+				return ec.fieldContext_Socio_qualificacaoRepresentanteLegal(ctx, field)
+			case "qualificacaoRepresentanteLegalRef":
+				return ec.fieldContext_Socio_qualificacaoRepresentanteLegalRef(ctx, field)
+			case "faixaEtaria":
+				return ec.fieldContext_Socio_faixaEtaria(ctx, field)
+			case "empresa":
+				return ec.fieldContext_Socio_empresa(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Socio", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sociosByCnpjBasico_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_cnaeByCodigo(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_cnaeByCodigo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CnaeByCodigo(rctx, fc.Args["codigo"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.CNAE)
+	fc.Result = res
+	return ec.marshalOCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_cnaeByCodigo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_CNAE_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_CNAE_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CNAE", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_cnaeByCodigo_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_simples(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_simples(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Simples(rctx, fc.Args["cnpjBasico"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Simples)
+	fc.Result = res
+	return ec.marshalOSimples2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSimples(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_simples(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Simples_cnpjBasico(ctx, field)
+			case "opcaoSimples":
+				return ec.fieldContext_Simples_opcaoSimples(ctx, field)
+			case "dataOpcaoSimples":
+				return ec.fieldContext_Simples_dataOpcaoSimples(ctx, field)
+			case "dataExclusaoSimples":
+				return ec.fieldContext_Simples_dataExclusaoSimples(ctx, field)
+			case "opcaoMEI":
+				return ec.fieldContext_Simples_opcaoMEI(ctx, field)
+			case "dataOpcaoMEI":
+				return ec.fieldContext_Simples_dataOpcaoMEI(ctx, field)
+			case "dataExclusaoMEI":
+				return ec.fieldContext_Simples_dataExclusaoMEI(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Simples", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_simples_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_buscarProspeccao(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_buscarProspeccao(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().BuscarProspeccao(rctx, fc.Args["filter"].(*model.ProspeccaoFilter), fc.Args["where"].(*model.FiltroExpr), fc.Args["texto"].(*string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.ProspeccaoDetalhada)
+	fc.Result = res
+	return ec.marshalNProspeccaoDetalhada2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhadaᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_buscarProspeccao(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "empresa":
+				return ec.fieldContext_ProspeccaoDetalhada_empresa(ctx, field)
+			case "estabelecimento":
+				return ec.fieldContext_ProspeccaoDetalhada_estabelecimento(ctx, field)
+			case "socios":
+				return ec.fieldContext_ProspeccaoDetalhada_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_ProspeccaoDetalhada_simples(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeFiscal(ctx, field)
+			case "cnaeSecundaria":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeSecundaria(ctx, field)
+			case "score":
+				return ec.fieldContext_ProspeccaoDetalhada_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoDetalhada", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_buscarProspeccao_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_buscarProspeccaoConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_buscarProspeccaoConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().BuscarProspeccaoConnection(rctx, fc.Args["filter"].(*model.ProspeccaoFilter), fc.Args["where"].(*model.FiltroExpr), fc.Args["texto"].(*string), fc.Args["first"].(*int), fc.Args["after"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.ProspeccaoConnection)
+	fc.Result = res
+	return ec.marshalNProspeccaoConnection2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_buscarProspeccaoConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_ProspeccaoConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ProspeccaoConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_ProspeccaoConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_buscarProspeccaoConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_prospeccaoPorCnpjs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_prospeccaoPorCnpjs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProspeccaoPorCnpjs(rctx, fc.Args["cnpjs"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ProspeccaoLoteItem)
+	fc.Result = res
+	return ec.marshalNProspeccaoLoteItem2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐProspeccaoLoteItemᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_prospeccaoPorCnpjs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "entrada":
+				return ec.fieldContext_ProspeccaoLoteItem_entrada(ctx, field)
+			case "cnpj":
+				return ec.fieldContext_ProspeccaoLoteItem_cnpj(ctx, field)
+			case "encontrado":
+				return ec.fieldContext_ProspeccaoLoteItem_encontrado(ctx, field)
+			case "erro":
+				return ec.fieldContext_ProspeccaoLoteItem_erro(ctx, field)
+			case "prospeccao":
+				return ec.fieldContext_ProspeccaoLoteItem_prospeccao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoLoteItem", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_prospeccaoPorCnpjs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_empresasSimilares(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_empresasSimilares(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().EmpresasSimilares(rctx, fc.Args["nome"].(string), fc.Args["uf"].(*string), fc.Args["limiar"].(*float64), fc.Args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.ProspeccaoDetalhada)
+	fc.Result = res
+	return ec.marshalNProspeccaoDetalhada2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhadaᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_empresasSimilares(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "empresa":
+				return ec.fieldContext_ProspeccaoDetalhada_empresa(ctx, field)
+			case "estabelecimento":
+				return ec.fieldContext_ProspeccaoDetalhada_estabelecimento(ctx, field)
+			case "socios":
+				return ec.fieldContext_ProspeccaoDetalhada_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_ProspeccaoDetalhada_simples(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeFiscal(ctx, field)
+			case "cnaeSecundaria":
+				return ec.fieldContext_ProspeccaoDetalhada_cnaeSecundaria(ctx, field)
+			case "score":
+				return ec.fieldContext_ProspeccaoDetalhada_score(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProspeccaoDetalhada", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_empresasSimilares_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_mudancas(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_mudancas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Mudancas(rctx, fc.Args["filter"].(*model.MudancaFilter), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Mudanca)
+	fc.Result = res
+	return ec.marshalNMudanca2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐMudancaᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_mudancas(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Mudanca_id(ctx, field)
+			case "tipo":
+				return ec.fieldContext_Mudanca_tipo(ctx, field)
+			case "cnpj":
+				return ec.fieldContext_Mudanca_cnpj(ctx, field)
+			case "cnpjBasico":
+				return ec.fieldContext_Mudanca_cnpjBasico(ctx, field)
+			case "uf":
+				return ec.fieldContext_Mudanca_uf(ctx, field)
+			case "cnaeFiscal":
+				return ec.fieldContext_Mudanca_cnaeFiscal(ctx, field)
+			case "valorAnterior":
+				return ec.fieldContext_Mudanca_valorAnterior(ctx, field)
+			case "valorNovo":
+				return ec.fieldContext_Mudanca_valorNovo(ctx, field)
+			case "detectadaEm":
+				return ec.fieldContext_Mudanca_detectadaEm(ctx, field)
+			case "referencia":
+				return ec.fieldContext_Mudanca_referencia(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Mudanca", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_mudancas_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_dataVersion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_dataVersion(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DataVersion(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.VersaoDados)
+	fc.Result = res
+	return ec.marshalOVersaoDados2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoDados(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_dataVersion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VersaoDados_id(ctx, field)
+			case "referencia":
+				return ec.fieldContext_VersaoDados_referencia(ctx, field)
+			case "iniciadaEm":
+				return ec.fieldContext_VersaoDados_iniciadaEm(ctx, field)
+			case "concluidaEm":
+				return ec.fieldContext_VersaoDados_concluidaEm(ctx, field)
+			case "tabelas":
+				return ec.fieldContext_VersaoDados_tabelas(ctx, field)
+			case "arquivos":
+				return ec.fieldContext_VersaoDados_arquivos(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VersaoDados", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Referencia_codigo(ctx context.Context, field graphql.CollectedField, obj *models.Referencia) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Referencia_codigo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Codigo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Referencia_codigo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Referencia",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Referencia_descricao(ctx context.Context, field graphql.CollectedField, obj *models.Referencia) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Referencia_descricao(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Descricao, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Referencia_descricao(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Referencia",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Simples_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Simples_cnpjBasico(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CNPJBasico, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Simples_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Simples",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Simples_opcaoSimples(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Simples_opcaoSimples(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OpcaoSimples, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Simples_opcaoSimples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Simples",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Simples_dataOpcaoSimples(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Simples_dataOpcaoSimples(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DataOpcaoSimples, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(models.Date)
+	fc.Result = res
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Simples_dataOpcaoSimples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Simples",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Simples_dataExclusaoSimples(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Simples_dataExclusaoSimples(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DataExclusaoSimples, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(models.Date)
+	fc.Result = res
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Simples_dataExclusaoSimples(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Simples",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Simples_opcaoMEI(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Simples_opcaoMEI(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OpcaoMEI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Simples_opcaoMEI(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Simples",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Simples_dataOpcaoMEI(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Simples_dataOpcaoMEI(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DataOpcaoMEI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(models.Date)
+	fc.Result = res
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Simples_dataOpcaoMEI(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Simples",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Simples_dataExclusaoMEI(ctx context.Context, field graphql.CollectedField, obj *models.Simples) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Simples_dataExclusaoMEI(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DataExclusaoMEI, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(models.Date)
+	fc.Result = res
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Simples_dataExclusaoMEI(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Simples",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_cnpj(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_cnpj(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CNPJ, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_cnpj(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_cnpjBasico(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_cnpjBasico(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CNPJBasico, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_cnpjBasico(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_identificadorDeSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_identificadorDeSocio(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IdentificadorDeSocio, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_identificadorDeSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_tipo(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_tipo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Socio().Tipo(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TipoSocio)
+	fc.Result = res
+	return ec.marshalOTipoSocio2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoSocio(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_tipo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TipoSocio does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_nomeSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_nomeSocio(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NomeSocio, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_nomeSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_cnpjCpfSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_cnpjCpfSocio(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CNPJCPFSocio, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_cnpjCpfSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_qualificacaoSocio(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_qualificacaoSocio(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QualificacaoSocio, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_qualificacaoSocio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_qualificacaoSocioRef(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_qualificacaoSocioRef(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Socio().QualificacaoSocioRef(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Referencia)
+	fc.Result = res
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_qualificacaoSocioRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_Referencia_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_Referencia_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_dataEntradaSociedade(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_dataEntradaSociedade(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DataEntradaSociedade, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(models.Date)
+	fc.Result = res
+	return ec.marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_dataEntradaSociedade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_pais(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_pais(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pais, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_pais(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_paisRef(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_paisRef(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Socio().PaisRef(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Referencia)
+	fc.Result = res
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_paisRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_Referencia_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_Referencia_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_representanteLegal(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_representanteLegal(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RepresentanteLegal, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_representanteLegal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_nomeRepresentante(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_nomeRepresentante(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NomeRepresentante, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_nomeRepresentante(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_qualificacaoRepresentanteLegal(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_qualificacaoRepresentanteLegal(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QualificacaoRepresentanteLegal, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_qualificacaoRepresentanteLegal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_qualificacaoRepresentanteLegalRef(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_qualificacaoRepresentanteLegalRef(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Socio().QualificacaoRepresentanteLegalRef(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Referencia)
+	fc.Result = res
+	return ec.marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_qualificacaoRepresentanteLegalRef(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "codigo":
+				return ec.fieldContext_Referencia_codigo(ctx, field)
+			case "descricao":
+				return ec.fieldContext_Referencia_descricao(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Referencia", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_faixaEtaria(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_faixaEtaria(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FaixaEtaria, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_faixaEtaria(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Socio_empresa(ctx context.Context, field graphql.CollectedField, obj *models.Socio) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Socio_empresa(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Socio().Empresa(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Empresa)
+	fc.Result = res
+	return ec.marshalOEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Socio_empresa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Socio",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cnpjBasico":
+				return ec.fieldContext_Empresa_cnpjBasico(ctx, field)
+			case "razaoSocial":
+				return ec.fieldContext_Empresa_razaoSocial(ctx, field)
+			case "naturezaJuridica":
+				return ec.fieldContext_Empresa_naturezaJuridica(ctx, field)
+			case "qualificacaoResponsavel":
+				return ec.fieldContext_Empresa_qualificacaoResponsavel(ctx, field)
+			case "porteEmpresa":
+				return ec.fieldContext_Empresa_porteEmpresa(ctx, field)
+			case "porte":
+				return ec.fieldContext_Empresa_porte(ctx, field)
+			case "enteFederativoResponsavel":
+				return ec.fieldContext_Empresa_enteFederativoResponsavel(ctx, field)
+			case "capitalSocial":
+				return ec.fieldContext_Empresa_capitalSocial(ctx, field)
+			case "naturezaJuridicaRef":
+				return ec.fieldContext_Empresa_naturezaJuridicaRef(ctx, field)
+			case "qualificacaoResponsavelRef":
+				return ec.fieldContext_Empresa_qualificacaoResponsavelRef(ctx, field)
+			case "estabelecimentos":
+				return ec.fieldContext_Empresa_estabelecimentos(ctx, field)
+			case "matriz":
+				return ec.fieldContext_Empresa_matriz(ctx, field)
+			case "socios":
+				return ec.fieldContext_Empresa_socios(ctx, field)
+			case "simples":
+				return ec.fieldContext_Empresa_simples(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Empresa", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_progressoImportacao(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_progressoImportacao(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ProgressoImportacao(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *models.ProgressoImportacao):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNProgressoImportacao2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProgressoImportacao(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_progressoImportacao(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "referencia":
+				return ec.fieldContext_ProgressoImportacao_referencia(ctx, field)
+			case "fase":
+				return ec.fieldContext_ProgressoImportacao_fase(ctx, field)
+			case "tabela":
+				return ec.fieldContext_ProgressoImportacao_tabela(ctx, field)
+			case "arquivo":
+				return ec.fieldContext_ProgressoImportacao_arquivo(ctx, field)
+			case "linhasProcessadas":
+				return ec.fieldContext_ProgressoImportacao_linhasProcessadas(ctx, field)
+			case "rejeitadas":
+				return ec.fieldContext_ProgressoImportacao_rejeitadas(ctx, field)
+			case "etaSegundos":
+				return ec.fieldContext_ProgressoImportacao_etaSegundos(ctx, field)
+			case "mensagem":
+				return ec.fieldContext_ProgressoImportacao_mensagem(ctx, field)
+			case "em":
+				return ec.fieldContext_ProgressoImportacao_em(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProgressoImportacao", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoArquivo_tabela(ctx context.Context, field graphql.CollectedField, obj *models.VersaoArquivo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoArquivo_tabela(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tabela, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoArquivo_tabela(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoArquivo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoArquivo_arquivo(ctx context.Context, field graphql.CollectedField, obj *models.VersaoArquivo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoArquivo_arquivo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Arquivo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoArquivo_arquivo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoArquivo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoArquivo_checksum(ctx context.Context, field graphql.CollectedField, obj *models.VersaoArquivo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoArquivo_checksum(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Checksum, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoArquivo_checksum(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoArquivo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoArquivo_linhas(ctx context.Context, field graphql.CollectedField, obj *models.VersaoArquivo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoArquivo_linhas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Linhas, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoArquivo_linhas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoArquivo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoDados_id(ctx context.Context, field graphql.CollectedField, obj *models.VersaoDados) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoDados_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoDados_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoDados",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoDados_referencia(ctx context.Context, field graphql.CollectedField, obj *models.VersaoDados) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoDados_referencia(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Referencia, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoDados_referencia(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoDados",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoDados_iniciadaEm(ctx context.Context, field graphql.CollectedField, obj *models.VersaoDados) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoDados_iniciadaEm(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IniciadaEm, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoDados_iniciadaEm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoDados",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoDados_concluidaEm(ctx context.Context, field graphql.CollectedField, obj *models.VersaoDados) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoDados_concluidaEm(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConcluidaEm, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoDados_concluidaEm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoDados",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoDados_tabelas(ctx context.Context, field graphql.CollectedField, obj *models.VersaoDados) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoDados_tabelas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tabelas, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.VersaoTabela)
+	fc.Result = res
+	return ec.marshalNVersaoTabela2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoTabelaᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoDados_tabelas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoDados",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tabela":
+				return ec.fieldContext_VersaoTabela_tabela(ctx, field)
+			case "referencia":
+				return ec.fieldContext_VersaoTabela_referencia(ctx, field)
+			case "linhas":
+				return ec.fieldContext_VersaoTabela_linhas(ctx, field)
+			case "rejeitadas":
+				return ec.fieldContext_VersaoTabela_rejeitadas(ctx, field)
+			case "carregadaEm":
+				return ec.fieldContext_VersaoTabela_carregadaEm(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VersaoTabela", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoDados_arquivos(ctx context.Context, field graphql.CollectedField, obj *models.VersaoDados) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoDados_arquivos(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Arquivos, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.VersaoArquivo)
+	fc.Result = res
+	return ec.marshalNVersaoArquivo2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoArquivoᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoDados_arquivos(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoDados",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "tabela":
+				return ec.fieldContext_VersaoArquivo_tabela(ctx, field)
+			case "arquivo":
+				return ec.fieldContext_VersaoArquivo_arquivo(ctx, field)
+			case "checksum":
+				return ec.fieldContext_VersaoArquivo_checksum(ctx, field)
+			case "linhas":
+				return ec.fieldContext_VersaoArquivo_linhas(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VersaoArquivo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoTabela_tabela(ctx context.Context, field graphql.CollectedField, obj *models.VersaoTabela) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoTabela_tabela(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tabela, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoTabela_tabela(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoTabela",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoTabela_referencia(ctx context.Context, field graphql.CollectedField, obj *models.VersaoTabela) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoTabela_referencia(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Referencia, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoTabela_referencia(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoTabela",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoTabela_linhas(ctx context.Context, field graphql.CollectedField, obj *models.VersaoTabela) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoTabela_linhas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Linhas, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoTabela_linhas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoTabela",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoTabela_rejeitadas(ctx context.Context, field graphql.CollectedField, obj *models.VersaoTabela) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoTabela_rejeitadas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rejeitadas, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoTabela_rejeitadas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoTabela",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VersaoTabela_carregadaEm(ctx context.Context, field graphql.CollectedField, obj *models.VersaoTabela) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VersaoTabela_carregadaEm(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CarregadaEm, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VersaoTabela_carregadaEm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VersaoTabela",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_isOneOf(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsOneOf(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputFaixaFiltro(ctx context.Context, obj any) (model.FaixaFiltro, error) {
+	var it model.FaixaFiltro
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"min", "max"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "min":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Min = data
+		case "max":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("max"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Max = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFiltroExpr(ctx context.Context, obj any) (model.FiltroExpr, error) {
+	var it model.FiltroExpr
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"and", "or", "not", "campo", "eq", "in", "range", "prefix", "contains"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "and":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("and"))
+			data, err := ec.unmarshalOFiltroExpr2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExprᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.And = data
+		case "or":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("or"))
+			data, err := ec.unmarshalOFiltroExpr2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExprᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Or = data
+		case "not":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("not"))
+			data, err := ec.unmarshalOFiltroExpr2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExpr(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Not = data
+		case "campo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("campo"))
+			data, err := ec.unmarshalOCampoFiltro2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐCampoFiltro(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Campo = data
+		case "eq":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Eq = data
+		case "in":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("in"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.In = data
+		case "range":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("range"))
+			data, err := ec.unmarshalOFaixaFiltro2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFaixaFiltro(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Range = data
+		case "prefix":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Prefix = data
+		case "contains":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("contains"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Contains = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMudancaFilter(ctx context.Context, obj any) (model.MudancaFilter, error) {
+	var it model.MudancaFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"tipos", "uf", "cnaeFiscal", "referencia", "dataInicio", "dataFim"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "tipos":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tipos"))
+			data, err := ec.unmarshalOTipoMudanca2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudancaᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tipos = data
+		case "uf":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("uf"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Uf = data
+		case "cnaeFiscal":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaeFiscal"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CnaeFiscal = data
+		case "referencia":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("referencia"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Referencia = data
+		case "dataInicio":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataInicio"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataInicio = data
+		case "dataFim":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataFim"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataFim = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputProspeccaoFilter(ctx context.Context, obj any) (model.ProspeccaoFilter, error) {
+	var it model.ProspeccaoFilter
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["cnaesModo"]; !present {
+		asMap["cnaesModo"] = "QUALQUER"
+	}
+
+	fieldsInOrder := [...]string{"cnpj", "razaoSocial", "nomeFantasia", "nomeSimilar", "nomeSimilarLimiar", "uf", "ufIn", "ufNotIn", "municipio", "municipioIn", "municipioNotIn", "situacaoCadastral", "situacao", "situacaoCadastralIn", "situacaoCadastralNotIn", "situacaoIn", "situacaoNotIn", "dataSituacaoCadastralMin", "dataSituacaoCadastralMax", "porteEmpresa", "porte", "porteEmpresaIn", "porteEmpresaNotIn", "porteIn", "porteNotIn", "tipoEstabelecimento", "naturezaJuridica", "naturezaJuridicaIn", "naturezaJuridicaNotIn", "cnaeFiscal", "cnaeFiscalIn", "cnaeFiscalNotIn", "cnaeFiscalSecundaria", "cnaes", "cnaesModo", "minCapitalSocial", "maxCapitalSocial", "dataInicioAtividadesMin", "dataInicioAtividadesMax", "opcaoSimples", "opcaoMEI", "dataOpcaoSimplesMin", "dataOpcaoSimplesMax", "dataExclusaoSimplesMin", "dataExclusaoSimplesMax", "dataOpcaoMEIMin", "dataOpcaoMEIMax", "dataExclusaoMEIMin", "dataExclusaoMEIMax"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "cnpj":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnpj"))
+			data, err := ec.unmarshalOCNPJ2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNPJ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Cnpj = data
+		case "razaoSocial":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("razaoSocial"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RazaoSocial = data
+		case "nomeFantasia":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nomeFantasia"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NomeFantasia = data
+		case "nomeSimilar":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nomeSimilar"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NomeSimilar = data
+		case "nomeSimilarLimiar":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nomeSimilarLimiar"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NomeSimilarLimiar = data
+		case "uf":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("uf"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Uf = data
+		case "ufIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ufIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UfIn = data
+		case "ufNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ufNotIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UfNotIn = data
+		case "municipio":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("municipio"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Municipio = data
+		case "municipioIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("municipioIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MunicipioIn = data
+		case "municipioNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("municipioNotIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MunicipioNotIn = data
+		case "situacaoCadastral":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("situacaoCadastral"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SituacaoCadastral = data
+		case "situacao":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("situacao"))
+			data, err := ec.unmarshalOSituacaoCadastral2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Situacao = data
+		case "situacaoCadastralIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("situacaoCadastralIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SituacaoCadastralIn = data
+		case "situacaoCadastralNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("situacaoCadastralNotIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SituacaoCadastralNotIn = data
+		case "situacaoIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("situacaoIn"))
+			data, err := ec.unmarshalOSituacaoCadastral2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastralᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SituacaoIn = data
+		case "situacaoNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("situacaoNotIn"))
+			data, err := ec.unmarshalOSituacaoCadastral2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastralᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SituacaoNotIn = data
+		case "dataSituacaoCadastralMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataSituacaoCadastralMin"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataSituacaoCadastralMin = data
+		case "dataSituacaoCadastralMax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataSituacaoCadastralMax"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataSituacaoCadastralMax = data
+		case "porteEmpresa":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("porteEmpresa"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PorteEmpresa = data
+		case "porte":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("porte"))
+			data, err := ec.unmarshalOPorteEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Porte = data
+		case "porteEmpresaIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("porteEmpresaIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PorteEmpresaIn = data
+		case "porteEmpresaNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("porteEmpresaNotIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PorteEmpresaNotIn = data
+		case "porteIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("porteIn"))
+			data, err := ec.unmarshalOPorteEmpresa2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresaᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PorteIn = data
+		case "porteNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("porteNotIn"))
+			data, err := ec.unmarshalOPorteEmpresa2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresaᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PorteNotIn = data
+		case "tipoEstabelecimento":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tipoEstabelecimento"))
+			data, err := ec.unmarshalOTipoEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoEstabelecimento(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TipoEstabelecimento = data
+		case "naturezaJuridica":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("naturezaJuridica"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NaturezaJuridica = data
+		case "naturezaJuridicaIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("naturezaJuridicaIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NaturezaJuridicaIn = data
+		case "naturezaJuridicaNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("naturezaJuridicaNotIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NaturezaJuridicaNotIn = data
+		case "cnaeFiscal":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaeFiscal"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CnaeFiscal = data
+		case "cnaeFiscalIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaeFiscalIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CnaeFiscalIn = data
+		case "cnaeFiscalNotIn":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaeFiscalNotIn"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CnaeFiscalNotIn = data
+		case "cnaeFiscalSecundaria":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaeFiscalSecundaria"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CnaeFiscalSecundaria = data
+		case "cnaes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaes"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Cnaes = data
+		case "cnaesModo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaesModo"))
+			data, err := ec.unmarshalOModoFiltroCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐModoFiltroCnae(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CnaesModo = data
+		case "minCapitalSocial":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minCapitalSocial"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinCapitalSocial = data
+		case "maxCapitalSocial":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxCapitalSocial"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxCapitalSocial = data
+		case "dataInicioAtividadesMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataInicioAtividadesMin"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataInicioAtividadesMin = data
+		case "dataInicioAtividadesMax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataInicioAtividadesMax"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataInicioAtividadesMax = data
+		case "opcaoSimples":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("opcaoSimples"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OpcaoSimples = data
+		case "opcaoMEI":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("opcaoMEI"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.RazaoSocial = data
-		case "nomeFantasia":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nomeFantasia"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+			it.OpcaoMei = data
+		case "dataOpcaoSimplesMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataOpcaoSimplesMin"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataOpcaoSimplesMin = data
+		case "dataOpcaoSimplesMax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataOpcaoSimplesMax"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataOpcaoSimplesMax = data
+		case "dataExclusaoSimplesMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataExclusaoSimplesMin"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataExclusaoSimplesMin = data
+		case "dataExclusaoSimplesMax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataExclusaoSimplesMax"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataExclusaoSimplesMax = data
+		case "dataOpcaoMEIMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataOpcaoMEIMin"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataOpcaoMEIMin = data
+		case "dataOpcaoMEIMax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataOpcaoMEIMax"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataOpcaoMEIMax = data
+		case "dataExclusaoMEIMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataExclusaoMEIMin"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataExclusaoMEIMin = data
+		case "dataExclusaoMEIMax":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataExclusaoMEIMax"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DataExclusaoMEIMax = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var cNAEImplementors = []string{"CNAE"}
+
+func (ec *executionContext) _CNAE(ctx context.Context, sel ast.SelectionSet, obj *models.CNAE) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cNAEImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CNAE")
+		case "codigo":
+			out.Values[i] = ec._CNAE_codigo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "descricao":
+			out.Values[i] = ec._CNAE_descricao(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var empresaImplementors = []string{"Empresa"}
+
+func (ec *executionContext) _Empresa(ctx context.Context, sel ast.SelectionSet, obj *models.Empresa) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, empresaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Empresa")
+		case "cnpjBasico":
+			out.Values[i] = ec._Empresa_cnpjBasico(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "razaoSocial":
+			out.Values[i] = ec._Empresa_razaoSocial(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "naturezaJuridica":
+			out.Values[i] = ec._Empresa_naturezaJuridica(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "qualificacaoResponsavel":
+			out.Values[i] = ec._Empresa_qualificacaoResponsavel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "porteEmpresa":
+			out.Values[i] = ec._Empresa_porteEmpresa(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "porte":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Empresa_porte(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "enteFederativoResponsavel":
+			out.Values[i] = ec._Empresa_enteFederativoResponsavel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "capitalSocial":
+			out.Values[i] = ec._Empresa_capitalSocial(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "naturezaJuridicaRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Empresa_naturezaJuridicaRef(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "qualificacaoResponsavelRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Empresa_qualificacaoResponsavelRef(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "estabelecimentos":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Empresa_estabelecimentos(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "matriz":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Empresa_matriz(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "socios":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Empresa_socios(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "simples":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Empresa_simples(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var estabelecimentoImplementors = []string{"Estabelecimento"}
+
+func (ec *executionContext) _Estabelecimento(ctx context.Context, sel ast.SelectionSet, obj *models.Estabelecimento) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, estabelecimentoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Estabelecimento")
+		case "id":
+			out.Values[i] = ec._Estabelecimento_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cnpj":
+			out.Values[i] = ec._Estabelecimento_cnpj(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cnpjFormatado":
+			out.Values[i] = ec._Estabelecimento_cnpjFormatado(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cnpjBasico":
+			out.Values[i] = ec._Estabelecimento_cnpjBasico(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "empresa":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Estabelecimento_empresa(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "cnpjOrdem":
+			out.Values[i] = ec._Estabelecimento_cnpjOrdem(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cnpjDv":
+			out.Values[i] = ec._Estabelecimento_cnpjDv(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "matrizFilial":
+			out.Values[i] = ec._Estabelecimento_matrizFilial(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tipo":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Estabelecimento_tipo(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "nomeFantasia":
+			out.Values[i] = ec._Estabelecimento_nomeFantasia(ctx, field, obj)
+		case "situacaoCadastral":
+			out.Values[i] = ec._Estabelecimento_situacaoCadastral(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "situacao":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Estabelecimento_situacao(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "dataSituacaoCadastral":
+			out.Values[i] = ec._Estabelecimento_dataSituacaoCadastral(ctx, field, obj)
+		case "motivoSituacaoCadastral":
+			out.Values[i] = ec._Estabelecimento_motivoSituacaoCadastral(ctx, field, obj)
+		case "motivoSituacaoCadastralRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Estabelecimento_motivoSituacaoCadastralRef(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "nomeCidadeExterior":
+			out.Values[i] = ec._Estabelecimento_nomeCidadeExterior(ctx, field, obj)
+		case "pais":
+			out.Values[i] = ec._Estabelecimento_pais(ctx, field, obj)
+		case "paisRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Estabelecimento_paisRef(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "dataInicioAtividades":
+			out.Values[i] = ec._Estabelecimento_dataInicioAtividades(ctx, field, obj)
+		case "cnaeFiscal":
+			out.Values[i] = ec._Estabelecimento_cnaeFiscal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cnaePrincipal":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Estabelecimento_cnaePrincipal(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "cnaeFiscalSecundaria":
+			out.Values[i] = ec._Estabelecimento_cnaeFiscalSecundaria(ctx, field, obj)
+		case "tipoLogradouro":
+			out.Values[i] = ec._Estabelecimento_tipoLogradouro(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "logradouro":
+			out.Values[i] = ec._Estabelecimento_logradouro(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "numero":
+			out.Values[i] = ec._Estabelecimento_numero(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "complemento":
+			out.Values[i] = ec._Estabelecimento_complemento(ctx, field, obj)
+		case "bairro":
+			out.Values[i] = ec._Estabelecimento_bairro(ctx, field, obj)
+		case "cep":
+			out.Values[i] = ec._Estabelecimento_cep(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "uf":
+			out.Values[i] = ec._Estabelecimento_uf(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "municipio":
+			out.Values[i] = ec._Estabelecimento_municipio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "municipioRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Estabelecimento_municipioRef(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "ddd1":
+			out.Values[i] = ec._Estabelecimento_ddd1(ctx, field, obj)
+		case "telefone1":
+			out.Values[i] = ec._Estabelecimento_telefone1(ctx, field, obj)
+		case "ddd2":
+			out.Values[i] = ec._Estabelecimento_ddd2(ctx, field, obj)
+		case "telefone2":
+			out.Values[i] = ec._Estabelecimento_telefone2(ctx, field, obj)
+		case "dddFax":
+			out.Values[i] = ec._Estabelecimento_dddFax(ctx, field, obj)
+		case "fax":
+			out.Values[i] = ec._Estabelecimento_fax(ctx, field, obj)
+		case "correioEletronico":
+			out.Values[i] = ec._Estabelecimento_correioEletronico(ctx, field, obj)
+		case "situacaoEspecial":
+			out.Values[i] = ec._Estabelecimento_situacaoEspecial(ctx, field, obj)
+		case "dataSituacaoEspecial":
+			out.Values[i] = ec._Estabelecimento_dataSituacaoEspecial(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mudancaImplementors = []string{"Mudanca"}
+
+func (ec *executionContext) _Mudanca(ctx context.Context, sel ast.SelectionSet, obj *models.Mudanca) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mudancaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mudanca")
+		case "id":
+			out.Values[i] = ec._Mudanca_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tipo":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Mudanca_tipo(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "cnpj":
+			out.Values[i] = ec._Mudanca_cnpj(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cnpjBasico":
+			out.Values[i] = ec._Mudanca_cnpjBasico(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "uf":
+			out.Values[i] = ec._Mudanca_uf(ctx, field, obj)
+		case "cnaeFiscal":
+			out.Values[i] = ec._Mudanca_cnaeFiscal(ctx, field, obj)
+		case "valorAnterior":
+			out.Values[i] = ec._Mudanca_valorAnterior(ctx, field, obj)
+		case "valorNovo":
+			out.Values[i] = ec._Mudanca_valorNovo(ctx, field, obj)
+		case "detectadaEm":
+			out.Values[i] = ec._Mudanca_detectadaEm(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "referencia":
+			out.Values[i] = ec._Mudanca_referencia(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *models.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var progressoImportacaoImplementors = []string{"ProgressoImportacao"}
+
+func (ec *executionContext) _ProgressoImportacao(ctx context.Context, sel ast.SelectionSet, obj *models.ProgressoImportacao) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, progressoImportacaoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProgressoImportacao")
+		case "referencia":
+			out.Values[i] = ec._ProgressoImportacao_referencia(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fase":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProgressoImportacao_fase(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "tabela":
+			out.Values[i] = ec._ProgressoImportacao_tabela(ctx, field, obj)
+		case "arquivo":
+			out.Values[i] = ec._ProgressoImportacao_arquivo(ctx, field, obj)
+		case "linhasProcessadas":
+			out.Values[i] = ec._ProgressoImportacao_linhasProcessadas(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "rejeitadas":
+			out.Values[i] = ec._ProgressoImportacao_rejeitadas(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "etaSegundos":
+			out.Values[i] = ec._ProgressoImportacao_etaSegundos(ctx, field, obj)
+		case "mensagem":
+			out.Values[i] = ec._ProgressoImportacao_mensagem(ctx, field, obj)
+		case "em":
+			out.Values[i] = ec._ProgressoImportacao_em(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prospeccaoConnectionImplementors = []string{"ProspeccaoConnection"}
+
+func (ec *executionContext) _ProspeccaoConnection(ctx context.Context, sel ast.SelectionSet, obj *models.ProspeccaoConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prospeccaoConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProspeccaoConnection")
+		case "edges":
+			out.Values[i] = ec._ProspeccaoConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pageInfo":
+			out.Values[i] = ec._ProspeccaoConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "totalCount":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProspeccaoConnection_totalCount(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prospeccaoDetalhadaImplementors = []string{"ProspeccaoDetalhada"}
+
+func (ec *executionContext) _ProspeccaoDetalhada(ctx context.Context, sel ast.SelectionSet, obj *models.ProspeccaoDetalhada) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prospeccaoDetalhadaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProspeccaoDetalhada")
+		case "empresa":
+			out.Values[i] = ec._ProspeccaoDetalhada_empresa(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "estabelecimento":
+			out.Values[i] = ec._ProspeccaoDetalhada_estabelecimento(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "socios":
+			out.Values[i] = ec._ProspeccaoDetalhada_socios(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "simples":
+			out.Values[i] = ec._ProspeccaoDetalhada_simples(ctx, field, obj)
+		case "cnaeFiscal":
+			out.Values[i] = ec._ProspeccaoDetalhada_cnaeFiscal(ctx, field, obj)
+		case "cnaeSecundaria":
+			out.Values[i] = ec._ProspeccaoDetalhada_cnaeSecundaria(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._ProspeccaoDetalhada_score(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prospeccaoEdgeImplementors = []string{"ProspeccaoEdge"}
+
+func (ec *executionContext) _ProspeccaoEdge(ctx context.Context, sel ast.SelectionSet, obj *models.ProspeccaoEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prospeccaoEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProspeccaoEdge")
+		case "cursor":
+			out.Values[i] = ec._ProspeccaoEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "node":
+			out.Values[i] = ec._ProspeccaoEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var prospeccaoLoteItemImplementors = []string{"ProspeccaoLoteItem"}
+
+func (ec *executionContext) _ProspeccaoLoteItem(ctx context.Context, sel ast.SelectionSet, obj *model.ProspeccaoLoteItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, prospeccaoLoteItemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProspeccaoLoteItem")
+		case "entrada":
+			out.Values[i] = ec._ProspeccaoLoteItem_entrada(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cnpj":
+			out.Values[i] = ec._ProspeccaoLoteItem_cnpj(ctx, field, obj)
+		case "encontrado":
+			out.Values[i] = ec._ProspeccaoLoteItem_encontrado(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "erro":
+			out.Values[i] = ec._ProspeccaoLoteItem_erro(ctx, field, obj)
+		case "prospeccao":
+			out.Values[i] = ec._ProspeccaoLoteItem_prospeccao(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "empresas":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_empresas(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "empresa":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_empresa(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "estabelecimento":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_estabelecimento(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "estabelecimentoPorCnpj":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_estabelecimentoPorCnpj(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "sociosByCnpjBasico":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sociosByCnpjBasico(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "cnaeByCodigo":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_cnaeByCodigo(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "simples":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_simples(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "buscarProspeccao":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_buscarProspeccao(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "buscarProspeccaoConnection":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_buscarProspeccaoConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "prospeccaoPorCnpjs":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_prospeccaoPorCnpjs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "empresasSimilares":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_empresasSimilares(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "mudancas":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_mudancas(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dataVersion":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dataVersion(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var referenciaImplementors = []string{"Referencia"}
+
+func (ec *executionContext) _Referencia(ctx context.Context, sel ast.SelectionSet, obj *models.Referencia) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, referenciaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Referencia")
+		case "codigo":
+			out.Values[i] = ec._Referencia_codigo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "descricao":
+			out.Values[i] = ec._Referencia_descricao(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var simplesImplementors = []string{"Simples"}
+
+func (ec *executionContext) _Simples(ctx context.Context, sel ast.SelectionSet, obj *models.Simples) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, simplesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Simples")
+		case "cnpjBasico":
+			out.Values[i] = ec._Simples_cnpjBasico(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "opcaoSimples":
+			out.Values[i] = ec._Simples_opcaoSimples(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dataOpcaoSimples":
+			out.Values[i] = ec._Simples_dataOpcaoSimples(ctx, field, obj)
+		case "dataExclusaoSimples":
+			out.Values[i] = ec._Simples_dataExclusaoSimples(ctx, field, obj)
+		case "opcaoMEI":
+			out.Values[i] = ec._Simples_opcaoMEI(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dataOpcaoMEI":
+			out.Values[i] = ec._Simples_dataOpcaoMEI(ctx, field, obj)
+		case "dataExclusaoMEI":
+			out.Values[i] = ec._Simples_dataExclusaoMEI(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var socioImplementors = []string{"Socio"}
+
+func (ec *executionContext) _Socio(ctx context.Context, sel ast.SelectionSet, obj *models.Socio) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, socioImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Socio")
+		case "cnpj":
+			out.Values[i] = ec._Socio_cnpj(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "cnpjBasico":
+			out.Values[i] = ec._Socio_cnpjBasico(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.NomeFantasia = data
-		case "uf":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("uf"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "identificadorDeSocio":
+			out.Values[i] = ec._Socio_identificadorDeSocio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.Uf = data
-		case "municipio":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("municipio"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "tipo":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Socio_tipo(ctx, field, obj)
+				return res
 			}
-			it.Municipio = data
-		case "situacaoCadastral":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("situacaoCadastral"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.SituacaoCadastral = data
-		case "dataSituacaoCadastralMin":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataSituacaoCadastralMin"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "nomeSocio":
+			out.Values[i] = ec._Socio_nomeSocio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.DataSituacaoCadastralMin = data
-		case "dataSituacaoCadastralMax":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataSituacaoCadastralMax"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "cnpjCpfSocio":
+			out.Values[i] = ec._Socio_cnpjCpfSocio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.DataSituacaoCadastralMax = data
-		case "porteEmpresa":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("porteEmpresa"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "qualificacaoSocio":
+			out.Values[i] = ec._Socio_qualificacaoSocio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.PorteEmpresa = data
-		case "naturezaJuridica":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("naturezaJuridica"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "qualificacaoSocioRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Socio_qualificacaoSocioRef(ctx, field, obj)
+				return res
 			}
-			it.NaturezaJuridica = data
-		case "cnaeFiscal":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaeFiscal"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.CnaeFiscal = data
-		case "cnaeFiscalSecundaria":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cnaeFiscalSecundaria"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "dataEntradaSociedade":
+			out.Values[i] = ec._Socio_dataEntradaSociedade(ctx, field, obj)
+		case "pais":
+			out.Values[i] = ec._Socio_pais(ctx, field, obj)
+		case "paisRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Socio_paisRef(ctx, field, obj)
+				return res
 			}
-			it.CnaeFiscalSecundaria = data
-		case "minCapitalSocial":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minCapitalSocial"))
-			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.MinCapitalSocial = data
-		case "maxCapitalSocial":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxCapitalSocial"))
-			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "representanteLegal":
+			out.Values[i] = ec._Socio_representanteLegal(ctx, field, obj)
+		case "nomeRepresentante":
+			out.Values[i] = ec._Socio_nomeRepresentante(ctx, field, obj)
+		case "qualificacaoRepresentanteLegal":
+			out.Values[i] = ec._Socio_qualificacaoRepresentanteLegal(ctx, field, obj)
+		case "qualificacaoRepresentanteLegalRef":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Socio_qualificacaoRepresentanteLegalRef(ctx, field, obj)
+				return res
 			}
-			it.MaxCapitalSocial = data
-		case "dataInicioAtividadesMin":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataInicioAtividadesMin"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.DataInicioAtividadesMin = data
-		case "dataInicioAtividadesMax":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dataInicioAtividadesMax"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "faixaEtaria":
+			out.Values[i] = ec._Socio_faixaEtaria(ctx, field, obj)
+		case "empresa":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Socio_empresa(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.DataInicioAtividadesMax = data
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion **************************** input.gotpl *****************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    ************************** interface.gotpl ***************************
+	return out
+}
 
-// endregion ************************** interface.gotpl ***************************
+var subscriptionImplementors = []string{"Subscription"}
 
-// region    **************************** object.gotpl ****************************
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
 
-var cNAEImplementors = []string{"CNAE"}
+	switch fields[0].Name {
+	case "progressoImportacao":
+		return ec._Subscription_progressoImportacao(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
 
-func (ec *executionContext) _CNAE(ctx context.Context, sel ast.SelectionSet, obj *models.CNAE) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cNAEImplementors)
+var versaoArquivoImplementors = []string{"VersaoArquivo"}
+
+func (ec *executionContext) _VersaoArquivo(ctx context.Context, sel ast.SelectionSet, obj *models.VersaoArquivo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, versaoArquivoImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CNAE")
-		case "codigo":
-			out.Values[i] = ec._CNAE_codigo(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("VersaoArquivo")
+		case "tabela":
+			out.Values[i] = ec._VersaoArquivo_tabela(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "descricao":
-			out.Values[i] = ec._CNAE_descricao(ctx, field, obj)
+		case "arquivo":
+			out.Values[i] = ec._VersaoArquivo_arquivo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "checksum":
+			out.Values[i] = ec._VersaoArquivo_checksum(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "linhas":
+			out.Values[i] = ec._VersaoArquivo_linhas(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7010,49 +14947,44 @@ func (ec *executionContext) _CNAE(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var empresaImplementors = []string{"Empresa"}
+var versaoDadosImplementors = []string{"VersaoDados"}
 
-func (ec *executionContext) _Empresa(ctx context.Context, sel ast.SelectionSet, obj *models.Empresa) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, empresaImplementors)
+func (ec *executionContext) _VersaoDados(ctx context.Context, sel ast.SelectionSet, obj *models.VersaoDados) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, versaoDadosImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Empresa")
-		case "cnpjBasico":
-			out.Values[i] = ec._Empresa_cnpjBasico(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "razaoSocial":
-			out.Values[i] = ec._Empresa_razaoSocial(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("VersaoDados")
+		case "id":
+			out.Values[i] = ec._VersaoDados_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "naturezaJuridica":
-			out.Values[i] = ec._Empresa_naturezaJuridica(ctx, field, obj)
+		case "referencia":
+			out.Values[i] = ec._VersaoDados_referencia(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "qualificacaoResponsavel":
-			out.Values[i] = ec._Empresa_qualificacaoResponsavel(ctx, field, obj)
+		case "iniciadaEm":
+			out.Values[i] = ec._VersaoDados_iniciadaEm(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "porteEmpresa":
-			out.Values[i] = ec._Empresa_porteEmpresa(ctx, field, obj)
+		case "concluidaEm":
+			out.Values[i] = ec._VersaoDados_concluidaEm(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "enteFederativoResponsavel":
-			out.Values[i] = ec._Empresa_enteFederativoResponsavel(ctx, field, obj)
+		case "tabelas":
+			out.Values[i] = ec._VersaoDados_tabelas(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "capitalSocial":
-			out.Values[i] = ec._Empresa_capitalSocial(ctx, field, obj)
+		case "arquivos":
+			out.Values[i] = ec._VersaoDados_arquivos(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7079,165 +15011,146 @@ func (ec *executionContext) _Empresa(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var estabelecimentoImplementors = []string{"Estabelecimento"}
+var versaoTabelaImplementors = []string{"VersaoTabela"}
 
-func (ec *executionContext) _Estabelecimento(ctx context.Context, sel ast.SelectionSet, obj *models.Estabelecimento) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, estabelecimentoImplementors)
+func (ec *executionContext) _VersaoTabela(ctx context.Context, sel ast.SelectionSet, obj *models.VersaoTabela) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, versaoTabelaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Estabelecimento")
-		case "id":
-			out.Values[i] = ec._Estabelecimento_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "cnpj":
-			out.Values[i] = ec._Estabelecimento_cnpj(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "cnpjFormatado":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Estabelecimento_cnpjFormatado(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "cnpjBasico":
-			out.Values[i] = ec._Estabelecimento_cnpjBasico(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "cnpjOrdem":
-			out.Values[i] = ec._Estabelecimento_cnpjOrdem(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "cnpjDv":
-			out.Values[i] = ec._Estabelecimento_cnpjDv(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "matrizFilial":
-			out.Values[i] = ec._Estabelecimento_matrizFilial(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("VersaoTabela")
+		case "tabela":
+			out.Values[i] = ec._VersaoTabela_tabela(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "nomeFantasia":
-			out.Values[i] = ec._Estabelecimento_nomeFantasia(ctx, field, obj)
-		case "situacaoCadastral":
-			out.Values[i] = ec._Estabelecimento_situacaoCadastral(ctx, field, obj)
+		case "referencia":
+			out.Values[i] = ec._VersaoTabela_referencia(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "dataSituacaoCadastral":
-			out.Values[i] = ec._Estabelecimento_dataSituacaoCadastral(ctx, field, obj)
+		case "linhas":
+			out.Values[i] = ec._VersaoTabela_linhas(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "motivoSituacaoCadastral":
-			out.Values[i] = ec._Estabelecimento_motivoSituacaoCadastral(ctx, field, obj)
-		case "nomeCidadeExterior":
-			out.Values[i] = ec._Estabelecimento_nomeCidadeExterior(ctx, field, obj)
-		case "pais":
-			out.Values[i] = ec._Estabelecimento_pais(ctx, field, obj)
-		case "dataInicioAtividades":
-			out.Values[i] = ec._Estabelecimento_dataInicioAtividades(ctx, field, obj)
+		case "rejeitadas":
+			out.Values[i] = ec._VersaoTabela_rejeitadas(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "cnaeFiscal":
-			out.Values[i] = ec._Estabelecimento_cnaeFiscal(ctx, field, obj)
+		case "carregadaEm":
+			out.Values[i] = ec._VersaoTabela_carregadaEm(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "cnaeFiscalSecundaria":
-			out.Values[i] = ec._Estabelecimento_cnaeFiscalSecundaria(ctx, field, obj)
-		case "tipoLogradouro":
-			out.Values[i] = ec._Estabelecimento_tipoLogradouro(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "logradouro":
-			out.Values[i] = ec._Estabelecimento_logradouro(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "numero":
-			out.Values[i] = ec._Estabelecimento_numero(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "complemento":
-			out.Values[i] = ec._Estabelecimento_complemento(ctx, field, obj)
-		case "bairro":
-			out.Values[i] = ec._Estabelecimento_bairro(ctx, field, obj)
-		case "cep":
-			out.Values[i] = ec._Estabelecimento_cep(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "uf":
-			out.Values[i] = ec._Estabelecimento_uf(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "municipio":
-			out.Values[i] = ec._Estabelecimento_municipio(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "ddd1":
-			out.Values[i] = ec._Estabelecimento_ddd1(ctx, field, obj)
-		case "telefone1":
-			out.Values[i] = ec._Estabelecimento_telefone1(ctx, field, obj)
-		case "ddd2":
-			out.Values[i] = ec._Estabelecimento_ddd2(ctx, field, obj)
-		case "telefone2":
-			out.Values[i] = ec._Estabelecimento_telefone2(ctx, field, obj)
-		case "dddFax":
-			out.Values[i] = ec._Estabelecimento_dddFax(ctx, field, obj)
-		case "fax":
-			out.Values[i] = ec._Estabelecimento_fax(ctx, field, obj)
-		case "correioEletronico":
-			out.Values[i] = ec._Estabelecimento_correioEletronico(ctx, field, obj)
-		case "situacaoEspecial":
-			out.Values[i] = ec._Estabelecimento_situacaoEspecial(ctx, field, obj)
-		case "dataSituacaoEspecial":
-			out.Values[i] = ec._Estabelecimento_dataSituacaoEspecial(ctx, field, obj)
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7261,39 +15174,41 @@ func (ec *executionContext) _Estabelecimento(ctx context.Context, sel ast.Select
 	return out
 }
 
-var prospeccaoDetalhadaImplementors = []string{"ProspeccaoDetalhada"}
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) _ProspeccaoDetalhada(ctx context.Context, sel ast.SelectionSet, obj *models.ProspeccaoDetalhada) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, prospeccaoDetalhadaImplementors)
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ProspeccaoDetalhada")
-		case "empresa":
-			out.Values[i] = ec._ProspeccaoDetalhada_empresa(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "estabelecimento":
-			out.Values[i] = ec._ProspeccaoDetalhada_estabelecimento(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "socios":
-			out.Values[i] = ec._ProspeccaoDetalhada_socios(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "cnaeFiscal":
-			out.Values[i] = ec._ProspeccaoDetalhada_cnaeFiscal(ctx, field, obj)
-		case "cnaeSecundaria":
-			out.Values[i] = ec._ProspeccaoDetalhada_cnaeSecundaria(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7317,156 +15232,93 @@ func (ec *executionContext) _ProspeccaoDetalhada(ctx context.Context, sel ast.Se
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "empresas":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_empresas(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "empresa":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_empresa(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "estabelecimento":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_estabelecimento(ctx, field)
-				return res
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "sociosByCnpjBasico":
-			field := field
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_sociosByCnpjBasico(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "cnaeByCodigo":
-			field := field
+var __SchemaImplementors = []string{"__Schema"}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_cnaeByCodigo(ctx, field)
-				return res
-			}
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "buscarProspeccao":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_buscarProspeccao(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7490,485 +15342,650 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var simplesImplementors = []string{"Simples"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _Simples(ctx context.Context, sel ast.SelectionSet, obj *models.Simples) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, simplesImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Simples")
-		case "cnpjBasico":
-			out.Values[i] = ec._Simples_cnpjBasico(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "opcaoSimples":
-			out.Values[i] = ec._Simples_opcaoSimples(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "dataOpcaoSimples":
-			out.Values[i] = ec._Simples_dataOpcaoSimples(ctx, field, obj)
-		case "dataExclusaoSimples":
-			out.Values[i] = ec._Simples_dataExclusaoSimples(ctx, field, obj)
-		case "opcaoMEI":
-			out.Values[i] = ec._Simples_opcaoMEI(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "dataOpcaoMEI":
-			out.Values[i] = ec._Simples_dataOpcaoMEI(ctx, field, obj)
-		case "dataExclusaoMEI":
-			out.Values[i] = ec._Simples_dataExclusaoMEI(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNCNAE2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAEᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.CNAE) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx context.Context, sel ast.SelectionSet, v *models.CNAE) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CNAE(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCNPJ2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNPJ(ctx context.Context, v any) (models.CNPJ, error) {
+	var res models.CNPJ
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCNPJ2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNPJ(ctx context.Context, sel ast.SelectionSet, v models.CNPJ) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx context.Context, v any) (models.Date, error) {
+	var res models.Date
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx context.Context, sel ast.SelectionSet, v models.Date) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNEmpresa2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresaᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Empresa) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx context.Context, sel ast.SelectionSet, v *models.Empresa) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Empresa(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNEstabelecimento2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimentoᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Estabelecimento) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var socioImplementors = []string{"Socio"}
-
-func (ec *executionContext) _Socio(ctx context.Context, sel ast.SelectionSet, obj *models.Socio) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, socioImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Socio")
-		case "cnpj":
-			out.Values[i] = ec._Socio_cnpj(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "cnpjBasico":
-			out.Values[i] = ec._Socio_cnpjBasico(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "identificadorDeSocio":
-			out.Values[i] = ec._Socio_identificadorDeSocio(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "nomeSocio":
-			out.Values[i] = ec._Socio_nomeSocio(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "cnpjCpfSocio":
-			out.Values[i] = ec._Socio_cnpjCpfSocio(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "qualificacaoSocio":
-			out.Values[i] = ec._Socio_qualificacaoSocio(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "dataEntradaSociedade":
-			out.Values[i] = ec._Socio_dataEntradaSociedade(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pais":
-			out.Values[i] = ec._Socio_pais(ctx, field, obj)
-		case "representanteLegal":
-			out.Values[i] = ec._Socio_representanteLegal(ctx, field, obj)
-		case "nomeRepresentante":
-			out.Values[i] = ec._Socio_nomeRepresentante(ctx, field, obj)
-		case "qualificacaoRepresentanteLegal":
-			out.Values[i] = ec._Socio_qualificacaoRepresentanteLegal(ctx, field, obj)
-		case "faixaEtaria":
-			out.Values[i] = ec._Socio_faixaEtaria(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx context.Context, sel ast.SelectionSet, v *models.Estabelecimento) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._Estabelecimento(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNFaseImportacao2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFaseImportacao(ctx context.Context, v any) (model.FaseImportacao, error) {
+	var res model.FaseImportacao
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) marshalNFaseImportacao2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFaseImportacao(ctx context.Context, sel ast.SelectionSet, v model.FaseImportacao) graphql.Marshaler {
+	return v
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) unmarshalNFiltroExpr2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExpr(ctx context.Context, v any) (*model.FiltroExpr, error) {
+	res, err := ec.unmarshalInputFiltroExpr(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloat(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return res
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
-
-	return out
+	return res
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNMudanca2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐMudancaᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Mudanca) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNMudanca2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐMudanca(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNMudanca2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐMudanca(ctx context.Context, sel ast.SelectionSet, v *models.Mudanca) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._Mudanca(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *models.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) unmarshalNPorteEmpresa2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx context.Context, v any) (model.PorteEmpresa, error) {
+	var res model.PorteEmpresa
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNPorteEmpresa2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx context.Context, sel ast.SelectionSet, v model.PorteEmpresa) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNProgressoImportacao2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProgressoImportacao(ctx context.Context, sel ast.SelectionSet, v models.ProgressoImportacao) graphql.Marshaler {
+	return ec._ProgressoImportacao(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProgressoImportacao2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProgressoImportacao(ctx context.Context, sel ast.SelectionSet, v *models.ProgressoImportacao) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._ProgressoImportacao(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNProspeccaoConnection2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoConnection(ctx context.Context, sel ast.SelectionSet, v models.ProspeccaoConnection) graphql.Marshaler {
+	return ec._ProspeccaoConnection(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNProspeccaoConnection2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoConnection(ctx context.Context, sel ast.SelectionSet, v *models.ProspeccaoConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._ProspeccaoConnection(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalNProspeccaoDetalhada2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhadaᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ProspeccaoDetalhada) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProspeccaoDetalhada2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhada(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+	}
+	wg.Wait()
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNProspeccaoDetalhada2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhada(ctx context.Context, sel ast.SelectionSet, v *models.ProspeccaoDetalhada) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._ProspeccaoDetalhada(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNProspeccaoEdge2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ProspeccaoEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProspeccaoEdge2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-var __SchemaImplementors = []string{"__Schema"}
+	return ret
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalNProspeccaoEdge2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoEdge(ctx context.Context, sel ast.SelectionSet, v *models.ProspeccaoEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ProspeccaoEdge(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNProspeccaoLoteItem2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐProspeccaoLoteItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ProspeccaoLoteItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNProspeccaoLoteItem2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐProspeccaoLoteItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNProspeccaoLoteItem2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐProspeccaoLoteItem(ctx context.Context, sel ast.SelectionSet, v *model.ProspeccaoLoteItem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._ProspeccaoLoteItem(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) unmarshalNSituacaoCadastral2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx context.Context, v any) (model.SituacaoCadastral, error) {
+	var res model.SituacaoCadastral
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalNSituacaoCadastral2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx context.Context, sel ast.SelectionSet, v model.SituacaoCadastral) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocioᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Socio) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSocio2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocio(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNSocio2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocio(ctx context.Context, sel ast.SelectionSet, v *models.Socio) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Socio(ctx, sel, v)
+}
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalBoolean(v)
+	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -7977,7 +15994,47 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
-func (ec *executionContext) marshalNCNAE2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAEᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.CNAE) graphql.Marshaler {
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNTipoMudanca2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudanca(ctx context.Context, v any) (model.TipoMudanca, error) {
+	var res model.TipoMudanca
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNTipoMudanca2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudanca(ctx context.Context, sel ast.SelectionSet, v model.TipoMudanca) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNVersaoArquivo2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoArquivoᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.VersaoArquivo) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8001,7 +16058,7 @@ func (ec *executionContext) marshalNCNAE2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneu
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx, sel, v[i])
+			ret[i] = ec.marshalNVersaoArquivo2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoArquivo(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8021,17 +16078,17 @@ func (ec *executionContext) marshalNCNAE2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneu
 	return ret
 }
 
-func (ec *executionContext) marshalNCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx context.Context, sel ast.SelectionSet, v *models.CNAE) graphql.Marshaler {
+func (ec *executionContext) marshalNVersaoArquivo2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoArquivo(ctx context.Context, sel ast.SelectionSet, v *models.VersaoArquivo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CNAE(ctx, sel, v)
+	return ec._VersaoArquivo(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNEmpresa2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresaᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Empresa) graphql.Marshaler {
+func (ec *executionContext) marshalNVersaoTabela2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoTabelaᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.VersaoTabela) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8055,7 +16112,7 @@ func (ec *executionContext) marshalNEmpresa2ᚕᚖgithubᚗcomᚋedufilhocruzᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx, sel, v[i])
+			ret[i] = ec.marshalNVersaoTabela2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoTabela(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8075,34 +16132,72 @@ func (ec *executionContext) marshalNEmpresa2ᚕᚖgithubᚗcomᚋedufilhocruzᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx context.Context, sel ast.SelectionSet, v *models.Empresa) graphql.Marshaler {
+func (ec *executionContext) marshalNVersaoTabela2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoTabela(ctx context.Context, sel ast.SelectionSet, v *models.VersaoTabela) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Empresa(ctx, sel, v)
+	return ec._VersaoTabela(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx context.Context, sel ast.SelectionSet, v *models.Estabelecimento) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		return graphql.Null
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return ec._Estabelecimento(ctx, sel, v)
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
-	res, err := graphql.UnmarshalFloat(v)
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalFloat(v)
+	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -8111,23 +16206,22 @@ func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.S
 	return res
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	return res
+	return res, nil
 }
 
-func (ec *executionContext) marshalNProspeccaoDetalhada2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhadaᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ProspeccaoDetalhada) graphql.Marshaler {
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8151,7 +16245,7 @@ func (ec *executionContext) marshalNProspeccaoDetalhada2ᚕᚖgithubᚗcomᚋedu
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNProspeccaoDetalhada2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhada(ctx, sel, v[i])
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8171,17 +16265,19 @@ func (ec *executionContext) marshalNProspeccaoDetalhada2ᚕᚖgithubᚗcomᚋedu
 	return ret
 }
 
-func (ec *executionContext) marshalNProspeccaoDetalhada2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhada(ctx context.Context, sel ast.SelectionSet, v *models.ProspeccaoDetalhada) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._ProspeccaoDetalhada(ctx, sel, v)
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocioᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Socio) graphql.Marshaler {
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8205,7 +16301,7 @@ func (ec *executionContext) marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋne
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSocio2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocio(ctx, sel, v[i])
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8225,37 +16321,11 @@ func (ec *executionContext) marshalNSocio2ᚕᚖgithubᚗcomᚋedufilhocruzᚋne
 	return ret
 }
 
-func (ec *executionContext) marshalNSocio2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSocio(ctx context.Context, sel ast.SelectionSet, v *models.Socio) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Socio(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8279,7 +16349,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8299,12 +16369,22 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
 	res := graphql.MarshalString(v)
 	if res == graphql.Null {
@@ -8315,14 +16395,220 @@ func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Conte
 	return res
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx context.Context, sel ast.SelectionSet, v *models.CNAE) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CNAE(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOCNPJ2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNPJ(ctx context.Context, v any) (*models.CNPJ, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(models.CNPJ)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCNPJ2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNPJ(ctx context.Context, sel ast.SelectionSet, v *models.CNPJ) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOCampoFiltro2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐCampoFiltro(ctx context.Context, v any) (*model.CampoFiltro, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.CampoFiltro)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCampoFiltro2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐCampoFiltro(ctx context.Context, sel ast.SelectionSet, v *model.CampoFiltro) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx context.Context, v any) (models.Date, error) {
+	var res models.Date
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODate2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx context.Context, sel ast.SelectionSet, v models.Date) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx context.Context, v any) (*models.Date, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(models.Date)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODate2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐDate(ctx context.Context, sel ast.SelectionSet, v *models.Date) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx context.Context, sel ast.SelectionSet, v *models.Empresa) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Empresa(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx context.Context, sel ast.SelectionSet, v *models.Estabelecimento) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Estabelecimento(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOFaixaFiltro2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFaixaFiltro(ctx context.Context, v any) (*model.FaixaFiltro, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputFaixaFiltro(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOFiltroExpr2ᚕᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExprᚄ(ctx context.Context, v any) ([]*model.FiltroExpr, error) {
+	if v == nil {
+		return nil, nil
+	}
 	var vSlice []any
 	vSlice = graphql.CoerceList(v)
 	var err error
-	res := make([]string, len(vSlice))
+	res := make([]*model.FiltroExpr, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNFiltroExpr2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExpr(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOFiltroExpr2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐFiltroExpr(ctx context.Context, v any) (*model.FiltroExpr, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputFiltroExpr(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloat(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalFloat(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOModoFiltroCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐModoFiltroCnae(ctx context.Context, v any) (*model.ModoFiltroCnae, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ModoFiltroCnae)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOModoFiltroCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐModoFiltroCnae(ctx context.Context, sel ast.SelectionSet, v *model.ModoFiltroCnae) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOMudancaFilter2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐMudancaFilter(ctx context.Context, v any) (*model.MudancaFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputMudancaFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOPorteEmpresa2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresaᚄ(ctx context.Context, v any) ([]model.PorteEmpresa, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.PorteEmpresa, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNPorteEmpresa2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -8330,7 +16616,10 @@ func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx conte
 	return res, nil
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalOPorteEmpresa2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresaᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PorteEmpresa) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8354,7 +16643,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+			ret[i] = ec.marshalNPorteEmpresa2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8374,67 +16663,73 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
+func (ec *executionContext) unmarshalOPorteEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx context.Context, v any) (*model.PorteEmpresa, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.PorteEmpresa)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
+func (ec *executionContext) marshalOPorteEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐPorteEmpresa(ctx context.Context, sel ast.SelectionSet, v *model.PorteEmpresa) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) marshalOProspeccaoDetalhada2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐProspeccaoDetalhada(ctx context.Context, sel ast.SelectionSet, v *models.ProspeccaoDetalhada) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ProspeccaoDetalhada(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+func (ec *executionContext) unmarshalOProspeccaoFilter2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐProspeccaoFilter(ctx context.Context, v any) (*model.ProspeccaoFilter, error) {
+	if v == nil {
+		return nil, nil
 	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
+	res, err := ec.unmarshalInputProspeccaoFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
+func (ec *executionContext) marshalOReferencia2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐReferencia(ctx context.Context, sel ast.SelectionSet, v *models.Referencia) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	wg.Wait()
+	return ec._Referencia(ctx, sel, v)
+}
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
+func (ec *executionContext) marshalOSimples2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐSimples(ctx context.Context, sel ast.SelectionSet, v *models.Simples) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-
-	return ret
+	return ec._Simples(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) unmarshalOSituacaoCadastral2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastralᚄ(ctx context.Context, v any) ([]model.SituacaoCadastral, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.SituacaoCadastral, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSituacaoCadastral2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOSituacaoCadastral2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastralᚄ(ctx context.Context, sel ast.SelectionSet, v []model.SituacaoCadastral) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8458,7 +16753,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNSituacaoCadastral2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8478,155 +16773,178 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) unmarshalOSituacaoCadastral2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx context.Context, v any) (*model.SituacaoCadastral, error) {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
+		return nil, nil
 	}
-	return ec.___Type(ctx, sel, v)
+	var res = new(model.SituacaoCadastral)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOSituacaoCadastral2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐSituacaoCadastral(ctx context.Context, sel ast.SelectionSet, v *model.SituacaoCadastral) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	return res
+	return res, nil
 }
 
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
 
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalBoolean(v)
-	return res
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any) (*bool, error) {
+func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalBoolean(v)
+	res, err := graphql.UnmarshalString(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	_ = sel
 	_ = ctx
-	res := graphql.MarshalBoolean(*v)
+	res := graphql.MarshalString(*v)
 	return res
 }
 
-func (ec *executionContext) marshalOCNAE2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐCNAE(ctx context.Context, sel ast.SelectionSet, v *models.CNAE) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._CNAE(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalOEmpresa2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEmpresa(ctx context.Context, sel ast.SelectionSet, v *models.Empresa) graphql.Marshaler {
+func (ec *executionContext) unmarshalOTipoEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoEstabelecimento(ctx context.Context, v any) (*model.TipoEstabelecimento, error) {
 	if v == nil {
-		return graphql.Null
+		return nil, nil
 	}
-	return ec._Empresa(ctx, sel, v)
+	var res = new(model.TipoEstabelecimento)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐEstabelecimento(ctx context.Context, sel ast.SelectionSet, v *models.Estabelecimento) graphql.Marshaler {
+func (ec *executionContext) marshalOTipoEstabelecimento2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoEstabelecimento(ctx context.Context, sel ast.SelectionSet, v *model.TipoEstabelecimento) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._Estabelecimento(ctx, sel, v)
+	return v
 }
 
-func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+func (ec *executionContext) unmarshalOTipoMudanca2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudancaᚄ(ctx context.Context, v any) ([]model.TipoMudanca, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalFloat(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.TipoMudanca, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNTipoMudanca2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudanca(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+func (ec *executionContext) marshalOTipoMudanca2ᚕgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudancaᚄ(ctx context.Context, sel ast.SelectionSet, v []model.TipoMudanca) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalFloat(*v)
-	return res
-}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTipoMudanca2githubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoMudanca(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
-	if v == nil {
-		return nil, nil
 	}
-	res, err := graphql.UnmarshalInt(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
+	wg.Wait()
 
-func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalInt(*v)
-	return res
+
+	return ret
 }
 
-func (ec *executionContext) unmarshalOProspeccaoFilter2ᚖbackendᚋgraphqlᚋmodelᚐProspeccaoFilter(ctx context.Context, v any) (*model.ProspeccaoFilter, error) {
+func (ec *executionContext) unmarshalOTipoSocio2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoSocio(ctx context.Context, v any) (*model.TipoSocio, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := ec.unmarshalInputProspeccaoFilter(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalOString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+	var res = new(model.TipoSocio)
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalString(v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
+func (ec *executionContext) marshalOTipoSocio2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋgraphqlᚋmodelᚐTipoSocio(ctx context.Context, sel ast.SelectionSet, v *model.TipoSocio) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		return graphql.Null
 	}
-	res, err := graphql.UnmarshalString(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return v
 }
 
-func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+func (ec *executionContext) marshalOVersaoDados2ᚖgithubᚗcomᚋedufilhocruzᚋneurocloserᚋbackendᚋmodelsᚐVersaoDados(ctx context.Context, sel ast.SelectionSet, v *models.VersaoDados) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalString(*v)
-	return res
+	return ec._VersaoDados(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+// loadReferencia busca a descrição de um código via Dataloader. Código vazio resolve para null.
+func loadReferencia(ctx context.Context, loader *dataloader.Loader, codigo string) (*models.Referencia, error) {
+	if codigo == "" {
+		return nil, nil
+	}
+	data, err := loader.Load(ctx, dataloader.StringKey(codigo))()
+	if err != nil {
+		return nil, err
+	}
+	ref, _ := data.(*models.Referencia)
+	return ref, nil
+}
+
+// stringValue devolve o valor de um campo opcional, ou vazio se ele for nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// loadOne busca um único registro via Dataloader (empresa, matriz, CNAE, Simples...).
+// Chave vazia ou registro inexistente resolvem para null.
+func loadOne[T any](ctx context.Context, loader *dataloader.Loader, key string) (*T, error) {
+	if key == "" {
+		return nil, nil
+	}
+	data, err := loader.Load(ctx, dataloader.StringKey(key))()
+	if err != nil {
+		return nil, err
+	}
+	v, _ := data.(*T)
+	return v, nil
+}
+
+// loadList busca uma lista via Dataloader (estabelecimentos, sócios...). Nunca retorna nil,
+// já que os campos de lista do schema não aceitam null.
+func loadList[T any](ctx context.Context, loader *dataloader.Loader, key string) ([]*T, error) {
+	data, err := loader.Load(ctx, dataloader.StringKey(key))()
+	if err != nil {
+		return nil, err
+	}
+	list, _ := data.([]*T)
+	if list == nil {
+		list = []*T{}
+	}
+	return list, nil
+}
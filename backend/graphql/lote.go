@@ -0,0 +1,148 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/edufilhocruz/neurocloser/backend/dataloaders"
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+// DefaultMaxCNPJsLote é o máximo de CNPJs por chamada de prospeccaoPorCnpjs quando Resolver.MaxCNPJsLote
+// não é configurado (ver LOTE_CNPJS_MAX em cmd/main.go).
+const DefaultMaxCNPJsLote = 1000
+
+// maxCNPJsLote retorna o máximo configurado de CNPJs por lote.
+func (r *Resolver) maxCNPJsLote() int {
+	if r.MaxCNPJsLote > 0 {
+		return r.MaxCNPJsLote
+	}
+	return DefaultMaxCNPJsLote
+}
+
+// normalizeLoteCNPJ aceita o CNPJ completo ou o CNPJ básico, com ou sem formatação. O tipo é decidido pela
+// quantidade de caracteres sem a formatação: 14 é o CNPJ completo e 8, o básico. Ao contrário do argumento
+// cnpjBasico das outras queries, valores curtos não são completados com zeros, porque em um lote não há
+// como saber se "191" é um CNPJ básico ou um CNPJ completo que perdeu os zeros à esquerda numa planilha.
+func normalizeLoteCNPJ(entrada string) (string, error) {
+	limpo := models.CleanCNPJ(entrada)
+	switch len(limpo) {
+	case 14:
+		return models.NormalizeCNPJ(entrada)
+	case 8:
+		return models.NormalizeCNPJBasico(limpo)
+	}
+	reason := fmt.Sprintf("informe o CNPJ completo (14 caracteres) ou o básico (8); a entrada tem %d", len(limpo))
+	if limpo != "" && strings.Trim(limpo, "0123456789") == "" && len(limpo) < 14 {
+		reason += "; se veio de uma planilha, confira se os zeros à esquerda foram perdidos"
+	}
+	return "", &models.ValidationError{Field: "cnpjs", Value: entrada, Reason: reason}
+}
+
+// prospeccaoLote resolve prospeccaoPorCnpjs. Os CNPJs completos são buscados em uma única consulta e os
+// básicos resolvem para a matriz; empresas, sócios, Simples e CNAEs vêm dos Dataloaders, que fazem as
+// consultas em lote com os mesmos métodos de repository das demais queries. Cada entrada tem um item na
+// resposta, na mesma posição: entradas inválidas trazem o motivo em erro e as não encontradas, encontrado = false.
+func (r *Resolver) prospeccaoLote(ctx context.Context, entradas []string) ([]*model.ProspeccaoLoteItem, error) {
+	if limite := r.maxCNPJsLote(); len(entradas) > limite {
+		return nil, &models.ValidationError{
+			Field:  "cnpjs",
+			Value:  fmt.Sprint(len(entradas)),
+			Reason: fmt.Sprintf("no máximo %d CNPJs por consulta", limite),
+		}
+	}
+
+	itens := make([]*model.ProspeccaoLoteItem, len(entradas))
+	var cnpjs, basicos []string
+	for i, entrada := range entradas {
+		itens[i] = &model.ProspeccaoLoteItem{Entrada: entrada}
+		cnpj, err := normalizeLoteCNPJ(entrada)
+		if err != nil {
+			var verr *models.ValidationError
+			if errors.As(err, &verr) {
+				itens[i].Erro = &verr.Reason
+			}
+			continue
+		}
+		itens[i].Cnpj = &cnpj
+		if len(cnpj) == 14 {
+			cnpjs = append(cnpjs, cnpj)
+		} else {
+			basicos = append(basicos, cnpj)
+		}
+	}
+
+	loaders := dataloaders.ForContext(ctx)
+	matrizThunk := loaders.MatrizByCNPJBasico.LoadMany(ctx, dataloader.NewKeysFromStrings(basicos))
+	porCNPJ, err := r.EstabelecimentoRepo.GetEstabelecimentosByCNPJs(cnpjs)
+	if err != nil {
+		return nil, err
+	}
+	estabelecimentosMap := make(map[string]*models.Estabelecimento, len(porCNPJ)+len(basicos))
+	for _, e := range porCNPJ {
+		estabelecimentosMap[e.CNPJ] = e
+	}
+	matrizes, errs := matrizThunk()
+	for i, data := range matrizes {
+		if errs != nil && errs[i] != nil {
+			return nil, errs[i]
+		}
+		if e, ok := data.(*models.Estabelecimento); ok && e != nil {
+			estabelecimentosMap[basicos[i]] = e
+		}
+	}
+
+	// Uma entrada repetida aponta para o mesmo estabelecimento; ele é carregado uma vez só.
+	var estabelecimentos []*models.Estabelecimento
+	seen := make(map[*models.Estabelecimento]bool)
+	for _, item := range itens {
+		if item.Cnpj == nil {
+			continue
+		}
+		if e := estabelecimentosMap[*item.Cnpj]; e != nil && !seen[e] {
+			seen[e] = true
+			estabelecimentos = append(estabelecimentos, e)
+		}
+	}
+
+	empresaKeys := make([]string, len(estabelecimentos))
+	for i, e := range estabelecimentos {
+		empresaKeys[i] = e.CNPJBasico
+	}
+	empresasData, errs := loaders.EmpresaByCNPJBasico.LoadMany(ctx, dataloader.NewKeysFromStrings(empresaKeys))()
+	// Sem a empresa o resultado não está completo (empresa é obrigatória): conta como não encontrado,
+	// como no JOIN de buscarProspeccao.
+	var completos []*models.Estabelecimento
+	var empresas []*models.Empresa
+	for i, data := range empresasData {
+		if errs != nil && errs[i] != nil {
+			return nil, errs[i]
+		}
+		if emp, ok := data.(*models.Empresa); ok && emp != nil {
+			completos = append(completos, estabelecimentos[i])
+			empresas = append(empresas, emp)
+		}
+	}
+
+	resultados, err := loadProspeccao(ctx, completos, empresas)
+	if err != nil {
+		return nil, err
+	}
+	prospeccoes := make(map[*models.Estabelecimento]*models.ProspeccaoDetalhada, len(resultados))
+	for i, p := range resultados {
+		prospeccoes[completos[i]] = p
+	}
+	for _, item := range itens {
+		if item.Cnpj == nil {
+			continue
+		}
+		item.Prospeccao = prospeccoes[estabelecimentosMap[*item.Cnpj]]
+		item.Encontrado = item.Prospeccao != nil
+	}
+	return itens, nil
+}
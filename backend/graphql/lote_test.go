@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+)
+
+func TestNormalizeLoteCNPJ(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"33.000.167/0001-01", "33000167000101"},
+		{"33000167000101", "33000167000101"},
+		{"12.abc.345/01de-35", "12ABC34501DE35"},
+		{"33000167", "33000167"},
+		{"12.345.678", "12345678"}, // Básico formatado
+		{" 12.ABC.345 ", "12ABC345"},
+		{"00000191", "00000191"},
+	}
+	for _, tt := range tests {
+		got, err := normalizeLoteCNPJ(tt.in)
+		if err != nil {
+			t.Errorf("normalizeLoteCNPJ(%q) retornou erro: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeLoteCNPJ(%q) = %q, esperado %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeLoteCNPJInvalido(t *testing.T) {
+	tests := []struct {
+		in     string
+		reason string // Trecho esperado no motivo
+	}{
+		{"191", "a entrada tem 3"},                        // Não vira o básico 00000191
+		{"191000100", "zeros à esquerda"},                 // Nem básico nem completo
+		{"3300016700010", "zeros à esquerda"},             // CNPJ que perdeu o zero à esquerda (13 dígitos)
+		{"330001670001", "zeros à esquerda"},              // ... ou dois (12 dígitos)
+		{"12ABC3450", "a entrada tem 9"},                  // Alfanumérico: sem a dica dos zeros
+		{"33.000.167/0001-02", "verificadores"},           // Completo com verificador errado
+		{"12.AB*.345", "o CNPJ básico deve ter 8 letras"}, // Básico com caractere inválido
+		{"", "a entrada tem 0"},
+	}
+	for _, tt := range tests {
+		_, err := normalizeLoteCNPJ(tt.in)
+		var verr *models.ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("normalizeLoteCNPJ(%q) = %v, esperado *ValidationError", tt.in, err)
+			continue
+		}
+		if !strings.Contains(verr.Reason, tt.reason) {
+			t.Errorf("normalizeLoteCNPJ(%q): motivo %q, esperado conter %q", tt.in, verr.Reason, tt.reason)
+		}
+	}
+	if _, err := normalizeLoteCNPJ("12ABC3450"); err != nil && strings.Contains(err.Error(), "zeros") {
+		t.Errorf("normalizeLoteCNPJ(%q): a dica dos zeros só vale para entradas numéricas", "12ABC3450")
+	}
+}
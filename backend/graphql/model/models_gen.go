@@ -2,24 +2,626 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+)
+
+type FaixaFiltro struct {
+	Min *string `json:"min,omitempty"`
+	Max *string `json:"max,omitempty"`
+}
+
+type FiltroExpr struct {
+	And      []*FiltroExpr `json:"and,omitempty"`
+	Or       []*FiltroExpr `json:"or,omitempty"`
+	Not      *FiltroExpr   `json:"not,omitempty"`
+	Campo    *CampoFiltro  `json:"campo,omitempty"`
+	Eq       *string       `json:"eq,omitempty"`
+	In       []string      `json:"in,omitempty"`
+	Range    *FaixaFiltro  `json:"range,omitempty"`
+	Prefix   *string       `json:"prefix,omitempty"`
+	Contains *string       `json:"contains,omitempty"`
+}
+
+type MudancaFilter struct {
+	Tipos      []TipoMudanca `json:"tipos,omitempty"`
+	Uf         *string       `json:"uf,omitempty"`
+	CnaeFiscal *string       `json:"cnaeFiscal,omitempty"`
+	Referencia *string       `json:"referencia,omitempty"`
+	DataInicio *models.Date  `json:"dataInicio,omitempty"`
+	DataFim    *models.Date  `json:"dataFim,omitempty"`
+}
+
 type ProspeccaoFilter struct {
-	Cnpj                     *string  `json:"cnpj,omitempty"`
-	RazaoSocial              *string  `json:"razaoSocial,omitempty"`
-	NomeFantasia             *string  `json:"nomeFantasia,omitempty"`
-	Uf                       *string  `json:"uf,omitempty"`
-	Municipio                *string  `json:"municipio,omitempty"`
-	SituacaoCadastral        *string  `json:"situacaoCadastral,omitempty"`
-	DataSituacaoCadastralMin *string  `json:"dataSituacaoCadastralMin,omitempty"`
-	DataSituacaoCadastralMax *string  `json:"dataSituacaoCadastralMax,omitempty"`
-	PorteEmpresa             *string  `json:"porteEmpresa,omitempty"`
-	NaturezaJuridica         *string  `json:"naturezaJuridica,omitempty"`
-	CnaeFiscal               *string  `json:"cnaeFiscal,omitempty"`
-	CnaeFiscalSecundaria     *string  `json:"cnaeFiscalSecundaria,omitempty"`
-	MinCapitalSocial         *float64 `json:"minCapitalSocial,omitempty"`
-	MaxCapitalSocial         *float64 `json:"maxCapitalSocial,omitempty"`
-	DataInicioAtividadesMin  *string  `json:"dataInicioAtividadesMin,omitempty"`
-	DataInicioAtividadesMax  *string  `json:"dataInicioAtividadesMax,omitempty"`
+	Cnpj                     *models.CNPJ         `json:"cnpj,omitempty"`
+	RazaoSocial              *string              `json:"razaoSocial,omitempty"`
+	NomeFantasia             *string              `json:"nomeFantasia,omitempty"`
+	NomeSimilar              *string              `json:"nomeSimilar,omitempty"`
+	NomeSimilarLimiar        *float64             `json:"nomeSimilarLimiar,omitempty"`
+	Uf                       *string              `json:"uf,omitempty"`
+	UfIn                     []string             `json:"ufIn,omitempty"`
+	UfNotIn                  []string             `json:"ufNotIn,omitempty"`
+	Municipio                *string              `json:"municipio,omitempty"`
+	MunicipioIn              []string             `json:"municipioIn,omitempty"`
+	MunicipioNotIn           []string             `json:"municipioNotIn,omitempty"`
+	SituacaoCadastral        *string              `json:"situacaoCadastral,omitempty"`
+	Situacao                 *SituacaoCadastral   `json:"situacao,omitempty"`
+	SituacaoCadastralIn      []string             `json:"situacaoCadastralIn,omitempty"`
+	SituacaoCadastralNotIn   []string             `json:"situacaoCadastralNotIn,omitempty"`
+	SituacaoIn               []SituacaoCadastral  `json:"situacaoIn,omitempty"`
+	SituacaoNotIn            []SituacaoCadastral  `json:"situacaoNotIn,omitempty"`
+	DataSituacaoCadastralMin *models.Date         `json:"dataSituacaoCadastralMin,omitempty"`
+	DataSituacaoCadastralMax *models.Date         `json:"dataSituacaoCadastralMax,omitempty"`
+	PorteEmpresa             *string              `json:"porteEmpresa,omitempty"`
+	Porte                    *PorteEmpresa        `json:"porte,omitempty"`
+	PorteEmpresaIn           []string             `json:"porteEmpresaIn,omitempty"`
+	PorteEmpresaNotIn        []string             `json:"porteEmpresaNotIn,omitempty"`
+	PorteIn                  []PorteEmpresa       `json:"porteIn,omitempty"`
+	PorteNotIn               []PorteEmpresa       `json:"porteNotIn,omitempty"`
+	TipoEstabelecimento      *TipoEstabelecimento `json:"tipoEstabelecimento,omitempty"`
+	NaturezaJuridica         *string              `json:"naturezaJuridica,omitempty"`
+	NaturezaJuridicaIn       []string             `json:"naturezaJuridicaIn,omitempty"`
+	NaturezaJuridicaNotIn    []string             `json:"naturezaJuridicaNotIn,omitempty"`
+	CnaeFiscal               *string              `json:"cnaeFiscal,omitempty"`
+	CnaeFiscalIn             []string             `json:"cnaeFiscalIn,omitempty"`
+	CnaeFiscalNotIn          []string             `json:"cnaeFiscalNotIn,omitempty"`
+	CnaeFiscalSecundaria     *string              `json:"cnaeFiscalSecundaria,omitempty"`
+	Cnaes                    []string             `json:"cnaes,omitempty"`
+	CnaesModo                *ModoFiltroCnae      `json:"cnaesModo,omitempty"`
+	MinCapitalSocial         *float64             `json:"minCapitalSocial,omitempty"`
+	MaxCapitalSocial         *float64             `json:"maxCapitalSocial,omitempty"`
+	DataInicioAtividadesMin  *models.Date         `json:"dataInicioAtividadesMin,omitempty"`
+	DataInicioAtividadesMax  *models.Date         `json:"dataInicioAtividadesMax,omitempty"`
+	OpcaoSimples             *string              `json:"opcaoSimples,omitempty"`
+	OpcaoMei                 *string              `json:"opcaoMEI,omitempty"`
+	DataOpcaoSimplesMin      *models.Date         `json:"dataOpcaoSimplesMin,omitempty"`
+	DataOpcaoSimplesMax      *models.Date         `json:"dataOpcaoSimplesMax,omitempty"`
+	DataExclusaoSimplesMin   *models.Date         `json:"dataExclusaoSimplesMin,omitempty"`
+	DataExclusaoSimplesMax   *models.Date         `json:"dataExclusaoSimplesMax,omitempty"`
+	DataOpcaoMEIMin          *models.Date         `json:"dataOpcaoMEIMin,omitempty"`
+	DataOpcaoMEIMax          *models.Date         `json:"dataOpcaoMEIMax,omitempty"`
+	DataExclusaoMEIMin       *models.Date         `json:"dataExclusaoMEIMin,omitempty"`
+	DataExclusaoMEIMax       *models.Date         `json:"dataExclusaoMEIMax,omitempty"`
+}
+
+type ProspeccaoLoteItem struct {
+	Entrada    string                      `json:"entrada"`
+	Cnpj       *string                     `json:"cnpj,omitempty"`
+	Encontrado bool                        `json:"encontrado"`
+	Erro       *string                     `json:"erro,omitempty"`
+	Prospeccao *models.ProspeccaoDetalhada `json:"prospeccao,omitempty"`
 }
 
 type Query struct {
 }
+
+type Subscription struct {
+}
+
+type CampoFiltro string
+
+const (
+	CampoFiltroCnpj                  CampoFiltro = "CNPJ"
+	CampoFiltroCnpjBasico            CampoFiltro = "CNPJ_BASICO"
+	CampoFiltroNomeFantasia          CampoFiltro = "NOME_FANTASIA"
+	CampoFiltroMatrizFilial          CampoFiltro = "MATRIZ_FILIAL"
+	CampoFiltroSituacaoCadastral     CampoFiltro = "SITUACAO_CADASTRAL"
+	CampoFiltroDataSituacaoCadastral CampoFiltro = "DATA_SITUACAO_CADASTRAL"
+	CampoFiltroDataInicioAtividades  CampoFiltro = "DATA_INICIO_ATIVIDADES"
+	CampoFiltroCnaeFiscal            CampoFiltro = "CNAE_FISCAL"
+	CampoFiltroCnaeFiscalSecundaria  CampoFiltro = "CNAE_FISCAL_SECUNDARIA"
+	CampoFiltroUf                    CampoFiltro = "UF"
+	CampoFiltroMunicipio             CampoFiltro = "MUNICIPIO"
+	CampoFiltroRazaoSocial           CampoFiltro = "RAZAO_SOCIAL"
+	CampoFiltroNaturezaJuridica      CampoFiltro = "NATUREZA_JURIDICA"
+	CampoFiltroPorteEmpresa          CampoFiltro = "PORTE_EMPRESA"
+	CampoFiltroCapitalSocial         CampoFiltro = "CAPITAL_SOCIAL"
+	CampoFiltroOpcaoSimples          CampoFiltro = "OPCAO_SIMPLES"
+	CampoFiltroDataOpcaoSimples      CampoFiltro = "DATA_OPCAO_SIMPLES"
+	CampoFiltroDataExclusaoSimples   CampoFiltro = "DATA_EXCLUSAO_SIMPLES"
+	CampoFiltroOpcaoMei              CampoFiltro = "OPCAO_MEI"
+	CampoFiltroDataOpcaoMei          CampoFiltro = "DATA_OPCAO_MEI"
+	CampoFiltroDataExclusaoMei       CampoFiltro = "DATA_EXCLUSAO_MEI"
+)
+
+var AllCampoFiltro = []CampoFiltro{
+	CampoFiltroCnpj,
+	CampoFiltroCnpjBasico,
+	CampoFiltroNomeFantasia,
+	CampoFiltroMatrizFilial,
+	CampoFiltroSituacaoCadastral,
+	CampoFiltroDataSituacaoCadastral,
+	CampoFiltroDataInicioAtividades,
+	CampoFiltroCnaeFiscal,
+	CampoFiltroCnaeFiscalSecundaria,
+	CampoFiltroUf,
+	CampoFiltroMunicipio,
+	CampoFiltroRazaoSocial,
+	CampoFiltroNaturezaJuridica,
+	CampoFiltroPorteEmpresa,
+	CampoFiltroCapitalSocial,
+	CampoFiltroOpcaoSimples,
+	CampoFiltroDataOpcaoSimples,
+	CampoFiltroDataExclusaoSimples,
+	CampoFiltroOpcaoMei,
+	CampoFiltroDataOpcaoMei,
+	CampoFiltroDataExclusaoMei,
+}
+
+func (e CampoFiltro) IsValid() bool {
+	switch e {
+	case CampoFiltroCnpj, CampoFiltroCnpjBasico, CampoFiltroNomeFantasia, CampoFiltroMatrizFilial, CampoFiltroSituacaoCadastral, CampoFiltroDataSituacaoCadastral, CampoFiltroDataInicioAtividades, CampoFiltroCnaeFiscal, CampoFiltroCnaeFiscalSecundaria, CampoFiltroUf, CampoFiltroMunicipio, CampoFiltroRazaoSocial, CampoFiltroNaturezaJuridica, CampoFiltroPorteEmpresa, CampoFiltroCapitalSocial, CampoFiltroOpcaoSimples, CampoFiltroDataOpcaoSimples, CampoFiltroDataExclusaoSimples, CampoFiltroOpcaoMei, CampoFiltroDataOpcaoMei, CampoFiltroDataExclusaoMei:
+		return true
+	}
+	return false
+}
+
+func (e CampoFiltro) String() string {
+	return string(e)
+}
+
+func (e *CampoFiltro) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CampoFiltro(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CampoFiltro", str)
+	}
+	return nil
+}
+
+func (e CampoFiltro) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CampoFiltro) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CampoFiltro) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type FaseImportacao string
+
+const (
+	FaseImportacaoParse  FaseImportacao = "PARSE"
+	FaseImportacaoLoad   FaseImportacao = "LOAD"
+	FaseImportacaoIndex  FaseImportacao = "INDEX"
+	FaseImportacaoSwap   FaseImportacao = "SWAP"
+	FaseImportacaoDone   FaseImportacao = "DONE"
+	FaseImportacaoFailed FaseImportacao = "FAILED"
+)
+
+var AllFaseImportacao = []FaseImportacao{
+	FaseImportacaoParse,
+	FaseImportacaoLoad,
+	FaseImportacaoIndex,
+	FaseImportacaoSwap,
+	FaseImportacaoDone,
+	FaseImportacaoFailed,
+}
+
+func (e FaseImportacao) IsValid() bool {
+	switch e {
+	case FaseImportacaoParse, FaseImportacaoLoad, FaseImportacaoIndex, FaseImportacaoSwap, FaseImportacaoDone, FaseImportacaoFailed:
+		return true
+	}
+	return false
+}
+
+func (e FaseImportacao) String() string {
+	return string(e)
+}
+
+func (e *FaseImportacao) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FaseImportacao(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FaseImportacao", str)
+	}
+	return nil
+}
+
+func (e FaseImportacao) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FaseImportacao) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FaseImportacao) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ModoFiltroCnae string
+
+const (
+	ModoFiltroCnaeQualquer  ModoFiltroCnae = "QUALQUER"
+	ModoFiltroCnaeTodos     ModoFiltroCnae = "TODOS"
+	ModoFiltroCnaePrincipal ModoFiltroCnae = "PRINCIPAL"
+)
+
+var AllModoFiltroCnae = []ModoFiltroCnae{
+	ModoFiltroCnaeQualquer,
+	ModoFiltroCnaeTodos,
+	ModoFiltroCnaePrincipal,
+}
+
+func (e ModoFiltroCnae) IsValid() bool {
+	switch e {
+	case ModoFiltroCnaeQualquer, ModoFiltroCnaeTodos, ModoFiltroCnaePrincipal:
+		return true
+	}
+	return false
+}
+
+func (e ModoFiltroCnae) String() string {
+	return string(e)
+}
+
+func (e *ModoFiltroCnae) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModoFiltroCnae(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModoFiltroCNAE", str)
+	}
+	return nil
+}
+
+func (e ModoFiltroCnae) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ModoFiltroCnae) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ModoFiltroCnae) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PorteEmpresa string
+
+const (
+	// Microempresa (01)
+	PorteEmpresaMe PorteEmpresa = "ME"
+	// Empresa de pequeno porte (03)
+	PorteEmpresaEpp PorteEmpresa = "EPP"
+	// Demais (05)
+	PorteEmpresaDemais PorteEmpresa = "DEMAIS"
+)
+
+var AllPorteEmpresa = []PorteEmpresa{
+	PorteEmpresaMe,
+	PorteEmpresaEpp,
+	PorteEmpresaDemais,
+}
+
+func (e PorteEmpresa) IsValid() bool {
+	switch e {
+	case PorteEmpresaMe, PorteEmpresaEpp, PorteEmpresaDemais:
+		return true
+	}
+	return false
+}
+
+func (e PorteEmpresa) String() string {
+	return string(e)
+}
+
+func (e *PorteEmpresa) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PorteEmpresa(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PorteEmpresa", str)
+	}
+	return nil
+}
+
+func (e PorteEmpresa) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PorteEmpresa) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PorteEmpresa) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SituacaoCadastral string
+
+const (
+	// Nula (01)
+	SituacaoCadastralNula SituacaoCadastral = "NULA"
+	// Ativa (02)
+	SituacaoCadastralAtiva SituacaoCadastral = "ATIVA"
+	// Suspensa (03)
+	SituacaoCadastralSuspensa SituacaoCadastral = "SUSPENSA"
+	// Inapta (04)
+	SituacaoCadastralInapta SituacaoCadastral = "INAPTA"
+	// Baixada (08)
+	SituacaoCadastralBaixada SituacaoCadastral = "BAIXADA"
+)
+
+var AllSituacaoCadastral = []SituacaoCadastral{
+	SituacaoCadastralNula,
+	SituacaoCadastralAtiva,
+	SituacaoCadastralSuspensa,
+	SituacaoCadastralInapta,
+	SituacaoCadastralBaixada,
+}
+
+func (e SituacaoCadastral) IsValid() bool {
+	switch e {
+	case SituacaoCadastralNula, SituacaoCadastralAtiva, SituacaoCadastralSuspensa, SituacaoCadastralInapta, SituacaoCadastralBaixada:
+		return true
+	}
+	return false
+}
+
+func (e SituacaoCadastral) String() string {
+	return string(e)
+}
+
+func (e *SituacaoCadastral) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SituacaoCadastral(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SituacaoCadastral", str)
+	}
+	return nil
+}
+
+func (e SituacaoCadastral) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SituacaoCadastral) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SituacaoCadastral) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TipoEstabelecimento string
+
+const (
+	// Matriz (1)
+	TipoEstabelecimentoMatriz TipoEstabelecimento = "MATRIZ"
+	// Filial (2)
+	TipoEstabelecimentoFilial TipoEstabelecimento = "FILIAL"
+)
+
+var AllTipoEstabelecimento = []TipoEstabelecimento{
+	TipoEstabelecimentoMatriz,
+	TipoEstabelecimentoFilial,
+}
+
+func (e TipoEstabelecimento) IsValid() bool {
+	switch e {
+	case TipoEstabelecimentoMatriz, TipoEstabelecimentoFilial:
+		return true
+	}
+	return false
+}
+
+func (e TipoEstabelecimento) String() string {
+	return string(e)
+}
+
+func (e *TipoEstabelecimento) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TipoEstabelecimento(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TipoEstabelecimento", str)
+	}
+	return nil
+}
+
+func (e TipoEstabelecimento) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TipoEstabelecimento) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TipoEstabelecimento) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TipoMudanca string
+
+const (
+	TipoMudancaCnpjAberto       TipoMudanca = "CNPJ_ABERTO"
+	TipoMudancaCnpjBaixado      TipoMudanca = "CNPJ_BAIXADO"
+	TipoMudancaCnpjInapto       TipoMudanca = "CNPJ_INAPTO"
+	TipoMudancaSituacaoAlterada TipoMudanca = "SITUACAO_ALTERADA"
+	TipoMudancaEnderecoAlterado TipoMudanca = "ENDERECO_ALTERADO"
+	TipoMudancaTelefoneAlterado TipoMudanca = "TELEFONE_ALTERADO"
+	TipoMudancaSocioEntrada     TipoMudanca = "SOCIO_ENTRADA"
+	TipoMudancaSocioSaida       TipoMudanca = "SOCIO_SAIDA"
+)
+
+var AllTipoMudanca = []TipoMudanca{
+	TipoMudancaCnpjAberto,
+	TipoMudancaCnpjBaixado,
+	TipoMudancaCnpjInapto,
+	TipoMudancaSituacaoAlterada,
+	TipoMudancaEnderecoAlterado,
+	TipoMudancaTelefoneAlterado,
+	TipoMudancaSocioEntrada,
+	TipoMudancaSocioSaida,
+}
+
+func (e TipoMudanca) IsValid() bool {
+	switch e {
+	case TipoMudancaCnpjAberto, TipoMudancaCnpjBaixado, TipoMudancaCnpjInapto, TipoMudancaSituacaoAlterada, TipoMudancaEnderecoAlterado, TipoMudancaTelefoneAlterado, TipoMudancaSocioEntrada, TipoMudancaSocioSaida:
+		return true
+	}
+	return false
+}
+
+func (e TipoMudanca) String() string {
+	return string(e)
+}
+
+func (e *TipoMudanca) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TipoMudanca(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TipoMudanca", str)
+	}
+	return nil
+}
+
+func (e TipoMudanca) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TipoMudanca) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TipoMudanca) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TipoSocio string
+
+const (
+	// Pessoa jurídica (1)
+	TipoSocioPj TipoSocio = "PJ"
+	// Pessoa física (2)
+	TipoSocioPf TipoSocio = "PF"
+	// Estrangeiro (3)
+	TipoSocioEstrangeiro TipoSocio = "ESTRANGEIRO"
+)
+
+var AllTipoSocio = []TipoSocio{
+	TipoSocioPj,
+	TipoSocioPf,
+	TipoSocioEstrangeiro,
+}
+
+func (e TipoSocio) IsValid() bool {
+	switch e {
+	case TipoSocioPj, TipoSocioPf, TipoSocioEstrangeiro:
+		return true
+	}
+	return false
+}
+
+func (e TipoSocio) String() string {
+	return string(e)
+}
+
+func (e *TipoSocio) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TipoSocio(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TipoSocio", str)
+	}
+	return nil
+}
+
+func (e TipoSocio) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TipoSocio) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TipoSocio) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
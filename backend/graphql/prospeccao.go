@@ -0,0 +1,371 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/edufilhocruz/neurocloser/backend/dataloaders"
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
+	"github.com/edufilhocruz/neurocloser/backend/models"
+	"github.com/edufilhocruz/neurocloser/backend/repositories"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+// prospeccaoFilters converte o filtro GraphQL nos critérios aceitos por FindEstabelecimentosByFilters.
+// Só entram no mapa os campos informados. Os enums viram os códigos da Receita, a expressão where,
+// convertida em *repositories.Filter, vai em filters["where"] e a busca textual em filters["texto"].
+// nomeSimilar vai com o limiar, o informado ou o padrão do Resolver, em filters["nomeSimilarLimiar"].
+func (r *Resolver) prospeccaoFilters(filter *model.ProspeccaoFilter, where *model.FiltroExpr, texto *string) (map[string]interface{}, error) {
+	filters := make(map[string]interface{})
+	if texto != nil {
+		filters["texto"] = *texto
+	}
+	if where != nil {
+		expr, err := filtroExpr(where)
+		if err != nil {
+			return nil, err
+		}
+		filters["where"] = expr
+	}
+	if filter == nil {
+		return filters, nil
+	}
+
+	strs := map[string]*string{
+		"razaoSocial":          filter.RazaoSocial,
+		"nomeFantasia":         filter.NomeFantasia,
+		"uf":                   filter.Uf,
+		"municipio":            filter.Municipio,
+		"situacaoCadastral":    filter.SituacaoCadastral,
+		"porteEmpresa":         filter.PorteEmpresa,
+		"naturezaJuridica":     filter.NaturezaJuridica,
+		"cnaeFiscal":           filter.CnaeFiscal,
+		"cnaeFiscalSecundaria": filter.CnaeFiscalSecundaria,
+		"opcaoSimples":         filter.OpcaoSimples,
+		"opcaoMEI":             filter.OpcaoMei,
+	}
+	for key, value := range strs {
+		if value != nil {
+			filters[key] = *value
+		}
+	}
+	dates := map[string]*models.Date{
+		"dataSituacaoCadastralMin": filter.DataSituacaoCadastralMin,
+		"dataSituacaoCadastralMax": filter.DataSituacaoCadastralMax,
+		"dataInicioAtividadesMin":  filter.DataInicioAtividadesMin,
+		"dataInicioAtividadesMax":  filter.DataInicioAtividadesMax,
+		"dataOpcaoSimplesMin":      filter.DataOpcaoSimplesMin,
+		"dataOpcaoSimplesMax":      filter.DataOpcaoSimplesMax,
+		"dataExclusaoSimplesMin":   filter.DataExclusaoSimplesMin,
+		"dataExclusaoSimplesMax":   filter.DataExclusaoSimplesMax,
+		"dataOpcaoMEIMin":          filter.DataOpcaoMEIMin,
+		"dataOpcaoMEIMax":          filter.DataOpcaoMEIMax,
+		"dataExclusaoMEIMin":       filter.DataExclusaoMEIMin,
+		"dataExclusaoMEIMax":       filter.DataExclusaoMEIMax,
+	}
+	for key, value := range dates {
+		if value != nil {
+			filters[key] = *value
+		}
+	}
+	lists := map[string][]string{
+		"ufIn":                   filter.UfIn,
+		"ufNotIn":                filter.UfNotIn,
+		"municipioIn":            filter.MunicipioIn,
+		"municipioNotIn":         filter.MunicipioNotIn,
+		"situacaoCadastralIn":    withEnumCodes(filter.SituacaoCadastralIn, filter.SituacaoIn, situacaoCadastralCodes),
+		"situacaoCadastralNotIn": withEnumCodes(filter.SituacaoCadastralNotIn, filter.SituacaoNotIn, situacaoCadastralCodes),
+		"porteEmpresaIn":         withEnumCodes(filter.PorteEmpresaIn, filter.PorteIn, porteEmpresaCodes),
+		"porteEmpresaNotIn":      withEnumCodes(filter.PorteEmpresaNotIn, filter.PorteNotIn, porteEmpresaCodes),
+		"naturezaJuridicaIn":     filter.NaturezaJuridicaIn,
+		"naturezaJuridicaNotIn":  filter.NaturezaJuridicaNotIn,
+		"cnaeFiscalIn":           filter.CnaeFiscalIn,
+		"cnaeFiscalNotIn":        filter.CnaeFiscalNotIn,
+	}
+	for key, values := range lists {
+		if len(values) > 0 {
+			filters[key] = values
+		}
+	}
+	if len(filter.Cnaes) > 0 {
+		filters["cnaes"] = filter.Cnaes
+		if filter.CnaesModo != nil {
+			filters["cnaesModo"] = modoFiltroCNAEModes[*filter.CnaesModo]
+		}
+	}
+	if filter.NomeSimilar != nil && strings.TrimSpace(*filter.NomeSimilar) != "" {
+		limiar, err := r.validLimiar("nomeSimilarLimiar", filter.NomeSimilarLimiar)
+		if err != nil {
+			return nil, err
+		}
+		filters["nomeSimilar"] = strings.TrimSpace(*filter.NomeSimilar)
+		filters["nomeSimilarLimiar"] = limiar
+	}
+	if filter.Cnpj != nil {
+		filters["cnpj"] = string(*filter.Cnpj)
+	}
+	if filter.MinCapitalSocial != nil {
+		filters["minCapitalSocial"] = *filter.MinCapitalSocial
+	}
+	if filter.MaxCapitalSocial != nil {
+		filters["maxCapitalSocial"] = *filter.MaxCapitalSocial
+	}
+
+	if err := enumFilter(filters, "situacaoCadastral", "situacao", filter.Situacao, situacaoCadastralCodes); err != nil {
+		return nil, err
+	}
+	if err := enumFilter(filters, "porteEmpresa", "porte", filter.Porte, porteEmpresaCodes); err != nil {
+		return nil, err
+	}
+	if err := enumFilter(filters, "matrizFilial", "tipoEstabelecimento", filter.TipoEstabelecimento, tipoEstabelecimentoCodes); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// Tamanho das páginas de buscarProspeccaoConnection (first) e das listas com limit (empresas e
+// buscarProspeccao) quando não é informado, e o máximo aceito.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// cursorPrefix identifica os cursores da prospecção, para que um cursor qualquer em base64 seja recusado.
+const cursorPrefix = "prospeccao:"
+
+// encodeCursor gera o cursor opaco de um resultado a partir do seu CNPJ (a chave da paginação keyset)
+// e, na busca textual, da sua relevância: "prospeccao:<cnpj>" ou "prospeccao:<cnpj>:<score>".
+// O score é gravado com a precisão de float4, o tipo do ts_rank (ver prospeccaoQuery.keyset).
+func encodeCursor(cnpj string, score *float64) string {
+	raw := cursorPrefix + cnpj
+	if score != nil {
+		raw += ":" + strconv.FormatFloat(float64(float32(*score)), 'g', -1, 32)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor devolve a posição guardada no cursor, ou um erro de validação se o cursor não foi gerado aqui.
+func decodeCursor(cursor string) (*repositories.ProspeccaoCursor, error) {
+	invalid := &models.ValidationError{Field: "after", Value: cursor, Reason: "cursor inválido"}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+	key, ok := strings.CutPrefix(string(raw), cursorPrefix)
+	if !ok {
+		return nil, invalid
+	}
+	cnpj, score, hasScore := strings.Cut(key, ":")
+	if cnpj == "" {
+		return nil, invalid
+	}
+	pos := &repositories.ProspeccaoCursor{CNPJ: cnpj}
+	if hasScore {
+		s, err := strconv.ParseFloat(score, 32)
+		if err != nil || math.IsNaN(s) || math.IsInf(s, 0) {
+			return nil, invalid
+		}
+		pos.Score = &s
+	}
+	return pos, nil
+}
+
+// pageSize valida o first de buscarProspeccaoConnection.
+func pageSize(first *int) (int, error) {
+	if first == nil {
+		return defaultPageSize, nil
+	}
+	if *first < 0 || *first > maxPageSize {
+		return 0, &models.ValidationError{
+			Field:  "first",
+			Value:  fmt.Sprint(*first),
+			Reason: fmt.Sprintf("first deve estar entre 0 e %d", maxPageSize),
+		}
+	}
+	return *first, nil
+}
+
+// listLimit valida o limit de empresas e buscarProspeccao. Sem ele, a consulta não teria LIMIT e leria a
+// tabela inteira.
+func listLimit(limit *int) (*int, error) {
+	n := defaultPageSize
+	if limit != nil {
+		if *limit < 1 || *limit > maxPageSize {
+			return nil, &models.ValidationError{
+				Field:  "limit",
+				Value:  fmt.Sprint(*limit),
+				Reason: fmt.Sprintf("limit deve estar entre 1 e %d", maxPageSize),
+			}
+		}
+		n = *limit
+	}
+	return &n, nil
+}
+
+// prospeccaoConnection monta a página de buscarProspeccaoConnection. O repository é consultado com um
+// resultado a mais do que o pedido, só para saber se existe uma próxima página.
+func prospeccaoConnection(ctx context.Context, repo repositories.EstabelecimentoRepository, filters map[string]interface{}, first *int, after *string) (*models.ProspeccaoConnection, error) {
+	size, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+	var pos *repositories.ProspeccaoCursor
+	if after != nil {
+		if pos, err = decodeCursor(*after); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := repo.FindEstabelecimentosByFiltersAfter(filters, pos, size+1)
+	if err != nil {
+		return nil, err
+	}
+	hasNext := len(rows) > size
+	if hasNext {
+		rows = rows[:size]
+	}
+	nodes, err := buildProspeccao(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &models.ProspeccaoConnection{
+		Edges:    make([]*models.ProspeccaoEdge, len(nodes)),
+		PageInfo: &models.PageInfo{HasNextPage: hasNext, HasPreviousPage: pos != nil},
+		Filtros:  filters,
+	}
+	for i, node := range nodes {
+		conn.Edges[i] = &models.ProspeccaoEdge{Cursor: encodeCursor(rows[i].CNPJ, node.Score), Node: node}
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn, nil
+}
+
+// enumFilter grava em filters[key] o código da Receita do enum informado. Se o código bruto também veio
+// no filtro (ex.: situacao e situacaoCadastral) e os dois não batem, a busca seria vazia: retorna um erro
+// de validação em vez disso.
+func enumFilter[E comparable](filters map[string]interface{}, key, field string, value *E, codes map[E]string) error {
+	if value == nil {
+		return nil
+	}
+	code := codes[*value]
+	if raw, ok := filters[key].(string); ok && raw != code && !sameEnum(codes, raw, *value) {
+		return &models.ValidationError{
+			Field:  field,
+			Value:  fmt.Sprint(*value),
+			Reason: fmt.Sprintf("%s não corresponde ao código informado em %s ('%s')", field, key, raw),
+		}
+	}
+	filters[key] = code
+	return nil
+}
+
+// empresaFromJoin monta a Empresa a partir das colunas emp_* trazidas pelo JOIN da prospecção.
+func empresaFromJoin(r *repositories.EstabelecimentoComEmpresa) *models.Empresa {
+	capital, _ := strconv.ParseFloat(r.EmpresaCapitalSocialStr.String, 64)
+	return &models.Empresa{
+		CNPJBasico:                r.CNPJBasico,
+		RazaoSocial:               r.EmpresaRazaoSocial.String,
+		NaturezaJuridica:          r.EmpresaNaturezaJuridrica.String,
+		QualificacaoResponsavel:   r.EmpresaQualificacaoResponsavel.String,
+		PorteEmpresa:              r.EmpresaPorteEmpresa.String,
+		EnteFederativoResponsavel: r.EmpresaEnteFederativoResponsavel.String,
+		CapitalSocial:             capital,
+	}
+}
+
+// buildProspeccao monta os resultados da prospecção a partir das linhas do JOIN, que já trazem a Empresa
+// e, na busca textual, o score.
+func buildProspeccao(ctx context.Context, rows []*repositories.EstabelecimentoComEmpresa) ([]*models.ProspeccaoDetalhada, error) {
+	estabelecimentos := make([]*models.Estabelecimento, len(rows))
+	empresas := make([]*models.Empresa, len(rows))
+	for i, row := range rows {
+		estabelecimento := row.Estabelecimento
+		estabelecimentos[i] = &estabelecimento
+		empresas[i] = empresaFromJoin(row)
+	}
+	resultados, err := loadProspeccao(ctx, estabelecimentos, empresas)
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range rows {
+		if row.Score.Valid {
+			score := row.Score.Float64
+			resultados[i].Score = &score
+		}
+	}
+	return resultados, nil
+}
+
+// loadProspeccao completa os estabelecimentos com sócios, Simples e CNAEs carregados pelos Dataloaders.
+// Os CNAEs secundários vêm de estabelecimento_cnae_secundaria, na ordem da Receita.
+// Todas as cargas são disparadas antes de esperar qualquer resultado, para que caiam nos mesmos lotes.
+func loadProspeccao(ctx context.Context, estabelecimentos []*models.Estabelecimento, empresas []*models.Empresa) ([]*models.ProspeccaoDetalhada, error) {
+	loaders := dataloaders.ForContext(ctx)
+
+	type pending struct {
+		socios     dataloader.Thunk
+		simples    dataloader.Thunk
+		cnae       dataloader.Thunk
+		secundaria dataloader.Thunk
+	}
+	thunks := make([]pending, len(estabelecimentos))
+	for i, e := range estabelecimentos {
+		thunks[i].socios = loaders.SociosByCNPJBasico.Load(ctx, dataloader.StringKey(e.CNPJBasico))
+		thunks[i].simples = loaders.SimplesByCNPJBasico.Load(ctx, dataloader.StringKey(e.CNPJBasico))
+		if e.CNAEFiscal != "" {
+			thunks[i].cnae = loaders.CNAEByCodigo.Load(ctx, dataloader.StringKey(e.CNAEFiscal))
+		}
+		thunks[i].secundaria = loaders.CNAESecundariosByCNPJ.Load(ctx, dataloader.StringKey(e.CNPJ))
+	}
+
+	resultados := make([]*models.ProspeccaoDetalhada, len(estabelecimentos))
+	for i, e := range estabelecimentos {
+		p := &models.ProspeccaoDetalhada{
+			Empresa:         empresas[i],
+			Estabelecimento: e,
+		}
+
+		data, err := thunks[i].socios()
+		if err != nil {
+			return nil, err
+		}
+		p.Socios, _ = data.([]*models.Socio)
+		if p.Socios == nil {
+			p.Socios = []*models.Socio{}
+		}
+
+		data, err = thunks[i].simples()
+		if err != nil {
+			return nil, err
+		}
+		p.Simples, _ = data.(*models.Simples)
+
+		if thunks[i].cnae != nil {
+			data, err := thunks[i].cnae()
+			if err != nil {
+				return nil, err
+			}
+			p.CNAEFiscal, _ = data.(*models.CNAE)
+		}
+
+		data, err = thunks[i].secundaria()
+		if err != nil {
+			return nil, err
+		}
+		p.CNAESecundaria, _ = data.([]*models.CNAE)
+		if p.CNAESecundaria == nil {
+			p.CNAESecundaria = []*models.CNAE{}
+		}
+		resultados[i] = p
+	}
+	return resultados, nil
+}
@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
+	"github.com/edufilhocruz/neurocloser/backend/models"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	score := 0.060792710632085800 // ts_rank lido como float64 (um float4 na origem)
+	tests := []struct {
+		cnpj  string
+		score *float64
+	}{
+		{"33000167000101", nil},
+		{"12ABC34501DE35", nil},
+		{"33000167000101", &score},
+		{"33000167000101", new(float64)},
+	}
+	for _, tt := range tests {
+		cursor := encodeCursor(tt.cnpj, tt.score)
+		pos, err := decodeCursor(cursor)
+		if err != nil {
+			t.Errorf("decodeCursor(encodeCursor(%q)) retornou erro: %v", tt.cnpj, err)
+			continue
+		}
+		if pos.CNPJ != tt.cnpj {
+			t.Errorf("CNPJ do cursor = %q, esperado %q", pos.CNPJ, tt.cnpj)
+		}
+		switch {
+		case tt.score == nil && pos.Score != nil:
+			t.Errorf("cursor sem score voltou com score %v", *pos.Score)
+		case tt.score != nil && (pos.Score == nil || *pos.Score != float64(float32(*tt.score))):
+			t.Errorf("score do cursor = %v, esperado o float4 %v", pos.Score, float64(float32(*tt.score)))
+		}
+	}
+
+	// Um score com mais precisão que float4 volta arredondado, como o ts_rank::float4 do keyset.
+	precise := 0.1234567890123
+	pos, err := decodeCursor(encodeCursor("33000167000101", &precise))
+	if err != nil || *pos.Score != float64(float32(precise)) {
+		t.Errorf("score = %v (%v), esperado %v", pos, err, float64(float32(precise)))
+	}
+}
+
+func TestDecodeCursorInvalido(t *testing.T) {
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	for _, cursor := range []string{
+		"não é base64!",
+		encode("33000167000101"),                // Sem o prefixo
+		encode("mudanca:33000167000101"),        // Prefixo de outro cursor
+		encode("prospeccao:"),                   // Sem CNPJ
+		encode("prospeccao:33000167000101:abc"), // Score não numérico
+		encode("prospeccao:33000167000101:NaN"),
+		encode("prospeccao:33000167000101:Inf"),
+		"",
+	} {
+		_, err := decodeCursor(cursor)
+		var verr *models.ValidationError
+		if !errors.As(err, &verr) || verr.Field != "after" {
+			t.Errorf("decodeCursor(%q) = %v, esperado um ValidationError em after", cursor, err)
+		}
+	}
+}
+
+func TestProspeccaoFiltersNomeSimilar(t *testing.T) {
+	r := &Resolver{LimiarSimilaridade: 0.45}
+	nome := " padaria sao jose "
+	filters, err := r.prospeccaoFilters(&model.ProspeccaoFilter{NomeSimilar: &nome}, nil, nil)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if filters["nomeSimilar"] != "padaria sao jose" || filters["nomeSimilarLimiar"] != 0.45 {
+		t.Errorf("filtros = %v, esperado nomeSimilar sem espaços e o limiar do Resolver", filters)
+	}
+
+	limiar := 0.6
+	filters, err = r.prospeccaoFilters(&model.ProspeccaoFilter{NomeSimilar: &nome, NomeSimilarLimiar: &limiar}, nil, nil)
+	if err != nil || filters["nomeSimilarLimiar"] != 0.6 {
+		t.Errorf("limiar informado: filtros = %v (%v)", filters, err)
+	}
+
+	for _, l := range []float64{0, -0.1, 1.5} {
+		l := l
+		_, err := r.prospeccaoFilters(&model.ProspeccaoFilter{NomeSimilar: &nome, NomeSimilarLimiar: &l}, nil, nil)
+		var verr *models.ValidationError
+		if !errors.As(err, &verr) || verr.Field != "nomeSimilarLimiar" {
+			t.Errorf("limiar %v: erro = %v, esperado um ValidationError em nomeSimilarLimiar", l, err)
+		}
+	}
+}
+
+func TestListLimit(t *testing.T) {
+	tests := []struct {
+		limit   *int
+		want    int
+		wantErr bool
+	}{
+		{nil, defaultPageSize, false},
+		{ptr(1), 1, false},
+		{ptr(maxPageSize), maxPageSize, false},
+		{ptr(0), 0, true},
+		{ptr(-1), 0, true},
+		{ptr(maxPageSize + 1), 0, true},
+	}
+	for _, tt := range tests {
+		got, err := listLimit(tt.limit)
+		if tt.wantErr {
+			var verr *models.ValidationError
+			if !errors.As(err, &verr) || verr.Field != "limit" {
+				t.Errorf("listLimit(%d): erro = %v, esperado um ValidationError em limit", *tt.limit, err)
+			}
+			continue
+		}
+		if err != nil || *got != tt.want {
+			t.Errorf("listLimit(%v) = %v (%v), esperado %d", tt.limit, got, err, tt.want)
+		}
+	}
+}
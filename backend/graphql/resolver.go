@@ -1,7 +1,26 @@
 package graphql
 
+import (
+	"github.com/edufilhocruz/neurocloser/backend/importer"
+	"github.com/edufilhocruz/neurocloser/backend/repositories"
+
+	"github.com/jmoiron/sqlx"
+)
+
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
-type Resolver struct{}
+type Resolver struct {
+	DB                  *sqlx.DB
+	EmpresaRepo         repositories.EmpresaRepository
+	EstabelecimentoRepo repositories.EstabelecimentoRepository
+	SocioRepo           repositories.SocioRepository
+	SimplesRepo         repositories.SimplesRepository
+	CNAERepo            repositories.CNAERepository
+	MudancaRepo         repositories.MudancaRepository
+	VersaoDadosRepo     repositories.VersaoDadosRepository
+	ProgressBroker      *importer.ProgressBroker // nil se o LISTEN não pôde ser aberto
+	MaxCNPJsLote        int                      // Máximo de CNPJs em prospeccaoPorCnpjs (0 usa DefaultMaxCNPJsLote)
+	LimiarSimilaridade  float64                  // Limiar padrão de empresasSimilares (0 usa DefaultLimiarSimilaridade)
+}
@@ -5,56 +5,294 @@ package graphql
 // Code generated by github.com/99designs/gqlgen version v0.17.76
 
 import (
-	"backend/graphql/generated"
-	"backend/graphql/model"
 	"context"
 	"fmt"
 
+	"github.com/edufilhocruz/neurocloser/backend/dataloaders"
+	"github.com/edufilhocruz/neurocloser/backend/graphql/generated"
+	"github.com/edufilhocruz/neurocloser/backend/graphql/model"
 	"github.com/edufilhocruz/neurocloser/backend/models"
 )
 
-// CnpjFormatado is the resolver for the cnpjFormatado field.
-func (r *estabelecimentoResolver) CnpjFormatado(ctx context.Context, obj *models.Estabelecimento) (string, error) {
-	panic(fmt.Errorf("not implemented: CnpjFormatado - cnpjFormatado"))
+// Porte is the resolver for the porte field.
+func (r *empresaResolver) Porte(ctx context.Context, obj *models.Empresa) (*model.PorteEmpresa, error) {
+	return enumFromCode(porteEmpresaCodes, obj.PorteEmpresa), nil
+}
+
+// NaturezaJuridicaRef is the resolver for the naturezaJuridicaRef field.
+func (r *empresaResolver) NaturezaJuridicaRef(ctx context.Context, obj *models.Empresa) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).NaturezaJuridicaByCodigo, obj.NaturezaJuridica)
+}
+
+// QualificacaoResponsavelRef is the resolver for the qualificacaoResponsavelRef field.
+func (r *empresaResolver) QualificacaoResponsavelRef(ctx context.Context, obj *models.Empresa) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).QualificacaoByCodigo, obj.QualificacaoResponsavel)
+}
+
+// Estabelecimentos is the resolver for the estabelecimentos field.
+func (r *empresaResolver) Estabelecimentos(ctx context.Context, obj *models.Empresa) ([]*models.Estabelecimento, error) {
+	return loadList[models.Estabelecimento](ctx, dataloaders.ForContext(ctx).EstabelecimentosByCNPJBasico, obj.CNPJBasico)
+}
+
+// Matriz is the resolver for the matriz field.
+func (r *empresaResolver) Matriz(ctx context.Context, obj *models.Empresa) (*models.Estabelecimento, error) {
+	return loadOne[models.Estabelecimento](ctx, dataloaders.ForContext(ctx).MatrizByCNPJBasico, obj.CNPJBasico)
+}
+
+// Socios is the resolver for the socios field.
+func (r *empresaResolver) Socios(ctx context.Context, obj *models.Empresa) ([]*models.Socio, error) {
+	return loadList[models.Socio](ctx, dataloaders.ForContext(ctx).SociosByCNPJBasico, obj.CNPJBasico)
+}
+
+// Simples is the resolver for the simples field.
+func (r *empresaResolver) Simples(ctx context.Context, obj *models.Empresa) (*models.Simples, error) {
+	return loadOne[models.Simples](ctx, dataloaders.ForContext(ctx).SimplesByCNPJBasico, obj.CNPJBasico)
+}
+
+// Empresa is the resolver for the empresa field.
+func (r *estabelecimentoResolver) Empresa(ctx context.Context, obj *models.Estabelecimento) (*models.Empresa, error) {
+	return loadOne[models.Empresa](ctx, dataloaders.ForContext(ctx).EmpresaByCNPJBasico, obj.CNPJBasico)
+}
+
+// Tipo is the resolver for the tipo field.
+func (r *estabelecimentoResolver) Tipo(ctx context.Context, obj *models.Estabelecimento) (*model.TipoEstabelecimento, error) {
+	return enumFromCode(tipoEstabelecimentoCodes, obj.MatrizFilial), nil
+}
+
+// Situacao is the resolver for the situacao field.
+func (r *estabelecimentoResolver) Situacao(ctx context.Context, obj *models.Estabelecimento) (*model.SituacaoCadastral, error) {
+	return enumFromCode(situacaoCadastralCodes, obj.SituacaoCadastral), nil
+}
+
+// MotivoSituacaoCadastralRef is the resolver for the motivoSituacaoCadastralRef field.
+func (r *estabelecimentoResolver) MotivoSituacaoCadastralRef(ctx context.Context, obj *models.Estabelecimento) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).MotivoByCodigo, stringValue(obj.MotivoSituacaoCadastral))
+}
+
+// PaisRef is the resolver for the paisRef field.
+func (r *estabelecimentoResolver) PaisRef(ctx context.Context, obj *models.Estabelecimento) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).PaisByCodigo, stringValue(obj.Pais))
+}
+
+// CnaePrincipal is the resolver for the cnaePrincipal field.
+func (r *estabelecimentoResolver) CnaePrincipal(ctx context.Context, obj *models.Estabelecimento) (*models.CNAE, error) {
+	return loadOne[models.CNAE](ctx, dataloaders.ForContext(ctx).CNAEByCodigo, obj.CNAEFiscal)
+}
+
+// MunicipioRef is the resolver for the municipioRef field.
+func (r *estabelecimentoResolver) MunicipioRef(ctx context.Context, obj *models.Estabelecimento) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).MunicipioByCodigo, obj.Municipio)
+}
+
+// Tipo is the resolver for the tipo field.
+func (r *mudancaResolver) Tipo(ctx context.Context, obj *models.Mudanca) (model.TipoMudanca, error) {
+	return model.TipoMudanca(obj.Tipo), nil
+}
+
+// Fase is the resolver for the fase field.
+func (r *progressoImportacaoResolver) Fase(ctx context.Context, obj *models.ProgressoImportacao) (model.FaseImportacao, error) {
+	return model.FaseImportacao(obj.Fase), nil
+}
+
+// TotalCount is the resolver for the totalCount field.
+func (r *prospeccaoConnectionResolver) TotalCount(ctx context.Context, obj *models.ProspeccaoConnection) (*int, error) {
+	total, err := r.EstabelecimentoRepo.CountEstabelecimentosByFilters(obj.Filtros)
+	if err != nil {
+		return nil, err
+	}
+	return &total, nil
 }
 
 // Empresas is the resolver for the empresas field.
 func (r *queryResolver) Empresas(ctx context.Context, limit *int, offset *int) ([]*models.Empresa, error) {
-	panic(fmt.Errorf("not implemented: Empresas - empresas"))
+	limit, err := listLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.EmpresaRepo.GetAllEmpresas(limit, offset)
 }
 
 // Empresa is the resolver for the empresa field.
 func (r *queryResolver) Empresa(ctx context.Context, cnpjBasico string) (*models.Empresa, error) {
-	panic(fmt.Errorf("not implemented: Empresa - empresa"))
+	cnpjBasico, err := models.NormalizeCNPJBasico(cnpjBasico)
+	if err != nil {
+		return nil, err
+	}
+	return r.EmpresaRepo.GetEmpresaByCNPJBasico(cnpjBasico)
 }
 
 // Estabelecimento is the resolver for the estabelecimento field.
 func (r *queryResolver) Estabelecimento(ctx context.Context, id int) (*models.Estabelecimento, error) {
-	panic(fmt.Errorf("not implemented: Estabelecimento - estabelecimento"))
+	return r.EstabelecimentoRepo.GetEstabelecimentoByID(id)
+}
+
+// EstabelecimentoPorCnpj is the resolver for the estabelecimentoPorCnpj field.
+func (r *queryResolver) EstabelecimentoPorCnpj(ctx context.Context, cnpj models.CNPJ) (*models.Estabelecimento, error) {
+	return r.EstabelecimentoRepo.GetEstabelecimentoByCNPJ(string(cnpj))
 }
 
 // SociosByCnpjBasico is the resolver for the sociosByCnpjBasico field.
 func (r *queryResolver) SociosByCnpjBasico(ctx context.Context, cnpjBasico string) ([]*models.Socio, error) {
-	panic(fmt.Errorf("not implemented: SociosByCnpjBasico - sociosByCnpjBasico"))
+	cnpjBasico, err := models.NormalizeCNPJBasico(cnpjBasico)
+	if err != nil {
+		return nil, err
+	}
+	return r.SocioRepo.GetSociosByCNPJBasico(cnpjBasico)
 }
 
 // CnaeByCodigo is the resolver for the cnaeByCodigo field.
 func (r *queryResolver) CnaeByCodigo(ctx context.Context, codigo string) (*models.CNAE, error) {
-	panic(fmt.Errorf("not implemented: CnaeByCodigo - cnaeByCodigo"))
+	return r.CNAERepo.GetCNAEByCodigo(codigo)
+}
+
+// Simples is the resolver for the simples field.
+func (r *queryResolver) Simples(ctx context.Context, cnpjBasico string) (*models.Simples, error) {
+	cnpjBasico, err := models.NormalizeCNPJBasico(cnpjBasico)
+	if err != nil {
+		return nil, err
+	}
+	return r.SimplesRepo.GetSimplesByCNPJBasico(cnpjBasico)
 }
 
 // BuscarProspeccao is the resolver for the buscarProspeccao field.
-func (r *queryResolver) BuscarProspeccao(ctx context.Context, filter *model.ProspeccaoFilter, limit *int, offset *int) ([]*models.ProspeccaoDetalhada, error) {
-	panic(fmt.Errorf("not implemented: BuscarProspeccao - buscarProspeccao"))
+func (r *queryResolver) BuscarProspeccao(ctx context.Context, filter *model.ProspeccaoFilter, where *model.FiltroExpr, texto *string, limit *int, offset *int) ([]*models.ProspeccaoDetalhada, error) {
+	limit, err := listLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := r.prospeccaoFilters(filter, where, texto)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.EstabelecimentoRepo.FindEstabelecimentosByFilters(filters, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return buildProspeccao(ctx, rows)
+}
+
+// BuscarProspeccaoConnection is the resolver for the buscarProspeccaoConnection field.
+func (r *queryResolver) BuscarProspeccaoConnection(ctx context.Context, filter *model.ProspeccaoFilter, where *model.FiltroExpr, texto *string, first *int, after *string) (*models.ProspeccaoConnection, error) {
+	filters, err := r.prospeccaoFilters(filter, where, texto)
+	if err != nil {
+		return nil, err
+	}
+	return prospeccaoConnection(ctx, r.EstabelecimentoRepo, filters, first, after)
+}
+
+// ProspeccaoPorCnpjs is the resolver for the prospeccaoPorCnpjs field.
+func (r *queryResolver) ProspeccaoPorCnpjs(ctx context.Context, cnpjs []string) ([]*model.ProspeccaoLoteItem, error) {
+	return r.prospeccaoLote(ctx, cnpjs)
+}
+
+// EmpresasSimilares is the resolver for the empresasSimilares field.
+func (r *queryResolver) EmpresasSimilares(ctx context.Context, nome string, uf *string, limiar *float64, limit *int) ([]*models.ProspeccaoDetalhada, error) {
+	return r.empresasSimilares(ctx, nome, uf, limiar, limit)
+}
+
+// Mudancas is the resolver for the mudancas field.
+func (r *queryResolver) Mudancas(ctx context.Context, filter *model.MudancaFilter, limit *int, offset *int) ([]*models.Mudanca, error) {
+	filters := make(map[string]interface{})
+	if filter != nil {
+		if len(filter.Tipos) > 0 {
+			tipos := make([]string, len(filter.Tipos))
+			for i, t := range filter.Tipos {
+				tipos[i] = t.String()
+			}
+			filters["tipos"] = tipos
+		}
+		if filter.Uf != nil {
+			filters["uf"] = *filter.Uf
+		}
+		if filter.CnaeFiscal != nil {
+			filters["cnaeFiscal"] = *filter.CnaeFiscal
+		}
+		if filter.Referencia != nil {
+			filters["referencia"] = *filter.Referencia
+		}
+		if filter.DataInicio != nil {
+			filters["dataInicio"] = *filter.DataInicio
+		}
+		if filter.DataFim != nil {
+			filters["dataFim"] = *filter.DataFim
+		}
+	}
+	return r.MudancaRepo.FindMudancas(filters, limit, offset)
+}
+
+// DataVersion is the resolver for the dataVersion field.
+func (r *queryResolver) DataVersion(ctx context.Context) (*models.VersaoDados, error) {
+	return r.VersaoDadosRepo.GetVersaoAtual()
+}
+
+// Tipo is the resolver for the tipo field.
+func (r *socioResolver) Tipo(ctx context.Context, obj *models.Socio) (*model.TipoSocio, error) {
+	return enumFromCode(tipoSocioCodes, obj.IdentificadorDeSocio), nil
+}
+
+// QualificacaoSocioRef is the resolver for the qualificacaoSocioRef field.
+func (r *socioResolver) QualificacaoSocioRef(ctx context.Context, obj *models.Socio) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).QualificacaoByCodigo, obj.QualificacaoSocio)
 }
 
+// PaisRef is the resolver for the paisRef field.
+func (r *socioResolver) PaisRef(ctx context.Context, obj *models.Socio) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).PaisByCodigo, stringValue(obj.Pais))
+}
+
+// QualificacaoRepresentanteLegalRef is the resolver for the qualificacaoRepresentanteLegalRef field.
+func (r *socioResolver) QualificacaoRepresentanteLegalRef(ctx context.Context, obj *models.Socio) (*models.Referencia, error) {
+	return loadReferencia(ctx, dataloaders.ForContext(ctx).QualificacaoByCodigo, stringValue(obj.QualificacaoRepresentanteLegal))
+}
+
+// Empresa is the resolver for the empresa field.
+func (r *socioResolver) Empresa(ctx context.Context, obj *models.Socio) (*models.Empresa, error) {
+	return loadOne[models.Empresa](ctx, dataloaders.ForContext(ctx).EmpresaByCNPJBasico, obj.CNPJBasico)
+}
+
+// ProgressoImportacao is the resolver for the progressoImportacao field.
+func (r *subscriptionResolver) ProgressoImportacao(ctx context.Context) (<-chan *models.ProgressoImportacao, error) {
+	if r.ProgressBroker == nil {
+		return nil, fmt.Errorf("acompanhamento de importação indisponível neste servidor")
+	}
+	return r.ProgressBroker.Subscribe(ctx), nil
+}
+
+// Empresa returns generated.EmpresaResolver implementation.
+func (r *Resolver) Empresa() generated.EmpresaResolver { return &empresaResolver{r} }
+
 // Estabelecimento returns generated.EstabelecimentoResolver implementation.
 func (r *Resolver) Estabelecimento() generated.EstabelecimentoResolver {
 	return &estabelecimentoResolver{r}
 }
 
+// Mudanca returns generated.MudancaResolver implementation.
+func (r *Resolver) Mudanca() generated.MudancaResolver { return &mudancaResolver{r} }
+
+// ProgressoImportacao returns generated.ProgressoImportacaoResolver implementation.
+func (r *Resolver) ProgressoImportacao() generated.ProgressoImportacaoResolver {
+	return &progressoImportacaoResolver{r}
+}
+
+// ProspeccaoConnection returns generated.ProspeccaoConnectionResolver implementation.
+func (r *Resolver) ProspeccaoConnection() generated.ProspeccaoConnectionResolver {
+	return &prospeccaoConnectionResolver{r}
+}
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Socio returns generated.SocioResolver implementation.
+func (r *Resolver) Socio() generated.SocioResolver { return &socioResolver{r} }
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type empresaResolver struct{ *Resolver }
 type estabelecimentoResolver struct{ *Resolver }
+type mudancaResolver struct{ *Resolver }
+type progressoImportacaoResolver struct{ *Resolver }
+type prospeccaoConnectionResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type socioResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
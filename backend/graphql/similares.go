@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+)
+
+// DefaultLimiarSimilaridade é a similaridade mínima de empresasSimilares quando nem o argumento limiar nem
+// Resolver.LimiarSimilaridade são informados (ver SIMILARIDADE_LIMIAR em cmd/main.go). É o padrão do pg_trgm.
+const DefaultLimiarSimilaridade = 0.3
+
+// Quantidade de resultados de empresasSimilares quando limit não é informado, e o máximo aceito.
+const (
+	defaultSimilaresLimit = 20
+	maxSimilaresLimit     = 100
+)
+
+// limiarSimilaridade retorna o limiar padrão configurado.
+func (r *Resolver) limiarSimilaridade() float64 {
+	if r.LimiarSimilaridade > 0 {
+		return r.LimiarSimilaridade
+	}
+	return DefaultLimiarSimilaridade
+}
+
+// validLimiar retorna o limiar informado no argumento field, ou o padrão quando ele é nil.
+func (r *Resolver) validLimiar(field string, limiar *float64) (float64, error) {
+	if limiar == nil {
+		return r.limiarSimilaridade(), nil
+	}
+	if *limiar <= 0 || *limiar > 1 {
+		return 0, &models.ValidationError{Field: field, Value: fmt.Sprint(*limiar), Reason: field + " deve ser maior que 0 e no máximo 1"}
+	}
+	return *limiar, nil
+}
+
+// empresasSimilares valida os argumentos de empresasSimilares e monta os resultados, que já vêm do
+// repository na ordem de similaridade.
+func (r *Resolver) empresasSimilares(ctx context.Context, nome string, uf *string, limiar *float64, limit *int) ([]*models.ProspeccaoDetalhada, error) {
+	nome = strings.TrimSpace(nome)
+	if nome == "" {
+		return nil, &models.ValidationError{Field: "nome", Reason: "o nome não pode ser vazio"}
+	}
+	l, err := r.validLimiar("limiar", limiar)
+	if err != nil {
+		return nil, err
+	}
+	n := defaultSimilaresLimit
+	if limit != nil {
+		if *limit < 1 || *limit > maxSimilaresLimit {
+			return nil, &models.ValidationError{
+				Field:  "limit",
+				Value:  fmt.Sprint(*limit),
+				Reason: fmt.Sprintf("limit deve estar entre 1 e %d", maxSimilaresLimit),
+			}
+		}
+		n = *limit
+	}
+	var u string
+	if uf != nil {
+		u = strings.ToUpper(strings.TrimSpace(*uf))
+	}
+
+	rows, err := r.EstabelecimentoRepo.FindEmpresasSimilares(nome, u, l, n)
+	if err != nil {
+		return nil, err
+	}
+	return buildProspeccao(ctx, rows)
+}
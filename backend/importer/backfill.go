@@ -0,0 +1,97 @@
+// neurocloser/backend/importer/backfill.go
+package importer
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+)
+
+// backfillStep preenche uma coluna ou tabela derivada que uma migration criou vazia.
+// query recebe o último CNPJ do lote anterior ($1) e o tamanho do lote ($2), preenche os
+// estabelecimentos seguintes e devolve o último CNPJ do lote (NULL quando não há mais nenhum).
+// Se requires não existir no banco (ex.: a geração _old), o passo é pulado.
+type backfillStep struct {
+	desc     string
+	requires string
+	query    string
+}
+
+// backfillSteps são os preenchimentos feitos por Backfill, na ordem.
+var backfillSteps = []backfillStep{
+	// busca fica vazia depois da migration 0006_busca_textual; estabelecimentos sem empresa ou com CNAE fora
+	// da tabela cnae recebem o documento com o que houver (busca_documento trata os NULLs).
+	{"estabelecimento.busca", "estabelecimento", `
+		WITH lote AS (
+			SELECT cnpj FROM estabelecimento WHERE cnpj > $1 ORDER BY cnpj LIMIT $2
+		), preenchidos AS (
+			UPDATE estabelecimento e
+			SET busca = busca_documento(
+				(SELECT emp.razao_social FROM empresas emp WHERE emp.cnpj_basico = e.cnpj_basico),
+				e.nome_fantasia,
+				(SELECT c.descricao FROM cnae c WHERE c.codigo = e.cnae_fiscal))
+			WHERE e.cnpj IN (SELECT cnpj FROM lote) AND e.busca IS NULL
+		)
+		SELECT max(cnpj) FROM lote
+	`},
+	// estabelecimento_cnae_secundaria é criada vazia pela migration 0008_cnae_secundaria, e a _old também,
+	// para que o rollback troque as duas gerações juntas.
+	{"estabelecimento_cnae_secundaria", "estabelecimento_cnae_secundaria", fmt.Sprintf(cnaeSecundariaBackfill, "")},
+	{"estabelecimento_cnae_secundaria" + previousSuffix, "estabelecimento_cnae_secundaria" + previousSuffix,
+		fmt.Sprintf(cnaeSecundariaBackfill, previousSuffix)},
+}
+
+// cnaeSecundariaBackfill é o INSERT de fillCNAESecundaria em lotes, para a geração com o sufixo %[1]s.
+// Estabelecimentos já preenchidos são ignorados (ON CONFLICT), então o passo pode ser repetido.
+const cnaeSecundariaBackfill = `
+	WITH lote AS (
+		SELECT cnpj, cnae_fiscal_secundaria FROM estabelecimento%[1]s WHERE cnpj > $1 ORDER BY cnpj LIMIT $2
+	), preenchidos AS (
+		INSERT INTO estabelecimento_cnae_secundaria%[1]s (cnpj, cnae, ordem)
+		SELECT e.cnpj, c.cnae, min(c.ordem)
+		FROM lote e
+		CROSS JOIN LATERAL (
+			SELECT trim(u.codigo) AS cnae, u.ordem
+			FROM unnest(string_to_array(e.cnae_fiscal_secundaria, ',')) WITH ORDINALITY AS u(codigo, ordem)
+		) c
+		WHERE c.cnae <> ''
+		GROUP BY e.cnpj, c.cnae
+		ON CONFLICT DO NOTHING
+	)
+	SELECT max(cnpj) FROM lote
+`
+
+// Backfill preenche na geração viva os dados derivados que as migrations deixam vazios, para que
+// aplicá-las (inclusive na subida da API) não dependa do tamanho da base. Cada carga do importador já
+// preenche esses dados; Backfill só é necessário para a geração que estava no ar quando a migration
+// foi aplicada. Roda em lotes de BatchSize estabelecimentos, um por transação, e pode ser interrompido
+// e executado de novo. Não rode junto com uma importação.
+func (imp *Importer) Backfill() error {
+	for _, step := range backfillSteps {
+		var exists bool
+		if err := imp.db.Get(&exists, "SELECT to_regclass($1) IS NOT NULL", step.requires); err != nil {
+			return fmt.Errorf("erro ao verificar a tabela '%s': %w", step.requires, err)
+		}
+		if !exists {
+			continue
+		}
+
+		start := time.Now()
+		var last string
+		var batches int
+		for {
+			var next sql.NullString
+			if err := imp.db.Get(&next, step.query, last, imp.opts.BatchSize); err != nil {
+				return fmt.Errorf("erro ao preencher '%s': %w", step.desc, err)
+			}
+			if !next.Valid {
+				break
+			}
+			last = next.String
+			batches++
+		}
+		log.Printf("Preenchimento de '%s' concluído: %d lote(s) em %v", step.desc, batches, time.Since(start).Round(time.Second))
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// neurocloser/backend/importer/catalog.go
+package importer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Valores de versoes_dados_tabelas.geracao: a versão de cada tabela que está no ar e a que está em _old.
+const (
+	liveGeneration     = "viva"
+	previousGeneration = "anterior"
+)
+
+// dataVersion é o que o catálogo registra de uma carga quando ela entra no ar.
+type dataVersion struct {
+	reference string
+	started   time.Time
+	rows      map[string]int64 // Linhas de cada <tabela>_staging, contadas em checkRowCount
+}
+
+// recordVersion grava a carga no catálogo (versoes_dados): mês de referência, início e fim,
+// linhas por tabela e os arquivos de origem com seus checksums, tirados dos checkpoints.
+// Roda na transação de troca, antes de clearCheckpoints.
+func recordVersion(tx *sqlx.Tx, specs []TableSpec, v *dataVersion) error {
+	names := make([]string, len(specs))
+	for i, spec := range specs {
+		names[i] = spec.Name
+	}
+
+	// Uma carga retomada começou na primeira execução, não nesta.
+	var versionID int64
+	query := `
+		INSERT INTO versoes_dados (referencia, iniciada_em)
+		SELECT $1, LEAST($2::timestamptz, (SELECT min(iniciado_em) FROM importacao_checkpoints WHERE tabela = ANY($3)))
+		RETURNING id
+	`
+	if err := tx.Get(&versionID, query, v.reference, v.started, pq.Array(names)); err != nil {
+		return fmt.Errorf("erro ao registrar a versão dos dados: %w", err)
+	}
+
+	for _, spec := range specs {
+		if _, err := tx.Exec(`
+			UPDATE versoes_dados_tabelas
+			SET geracao = CASE geracao WHEN $2 THEN $3 ELSE NULL END
+			WHERE tabela = $1 AND geracao IS NOT NULL
+		`, spec.Name, liveGeneration, previousGeneration); err != nil {
+			return fmt.Errorf("erro ao atualizar as versões de '%s': %w", spec.Name, err)
+		}
+
+		if _, err := tx.Exec(`
+			INSERT INTO versoes_dados_tabelas (versao_id, tabela, linhas, rejeitadas, geracao)
+			SELECT $1, $2, $3, COALESCE(SUM(rejeitadas), 0), $4
+			FROM importacao_checkpoints
+			WHERE tabela = $2
+		`, versionID, spec.Name, v.rows[spec.Name], liveGeneration); err != nil {
+			return fmt.Errorf("erro ao registrar a versão de '%s': %w", spec.Name, err)
+		}
+
+		if _, err := tx.Exec(`
+			INSERT INTO versoes_dados_arquivos (versao_id, tabela, arquivo, checksum, linhas)
+			SELECT $1, tabela, arquivo, checksum, linhas
+			FROM importacao_checkpoints
+			WHERE tabela = $2
+		`, versionID, spec.Name); err != nil {
+			return fmt.Errorf("erro ao registrar os arquivos de '%s': %w", spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// liveReference devolve o mês de referência da carga que está no ar em table, segundo o catálogo.
+// ok é false se a tabela não tem versão registrada (ex.: carregada antes do catálogo).
+func liveReference(tx *sqlx.Tx, table string) (ref string, ok bool, err error) {
+	var refs []string
+	err = tx.Select(&refs, `
+		SELECT v.referencia
+		FROM versoes_dados_tabelas t
+		JOIN versoes_dados v ON v.id = t.versao_id
+		WHERE t.tabela = $1 AND t.geracao = $2
+	`, table, liveGeneration)
+	if err != nil {
+		return "", false, fmt.Errorf("erro ao consultar a versão de '%s': %w", table, err)
+	}
+	if len(refs) == 0 {
+		return "", false, nil
+	}
+	return refs[0], true, nil
+}
+
+// swapVersionGenerations acompanha o Rollback no catálogo: a versão anterior da tabela volta a ser
+// a viva e vice-versa.
+func swapVersionGenerations(tx *sqlx.Tx, table string) error {
+	_, err := tx.Exec(`
+		UPDATE versoes_dados_tabelas
+		SET geracao = CASE geracao WHEN $2 THEN $3 ELSE $2 END
+		WHERE tabela = $1 AND geracao IN ($2, $3)
+	`, table, liveGeneration, previousGeneration)
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar as versões de '%s': %w", table, err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// neurocloser/backend/importer/changes.go
+package importer
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Expressões comparadas entre a carga nova (n) e a geração atual (o) de estabelecimento.
+const (
+	enderecoExpr = "concat_ws(' ', %[1]s.tipo_logradouro, %[1]s.logradouro, %[1]s.numero, %[1]s.complemento, %[1]s.bairro, %[1]s.cep, %[1]s.municipio, %[1]s.uf)"
+	telefoneExpr = "concat_ws(' ', %[1]s.ddd1, %[1]s.telefone1, %[1]s.ddd2, %[1]s.telefone2, %[1]s.ddd_fax, %[1]s.fax)"
+)
+
+// changeTypes são os tipos de mudança gravados pelas comparações de cada tabela (ver clearChanges).
+var changeTypes = map[string][]string{
+	"estabelecimento": {"CNPJ_ABERTO", "CNPJ_BAIXADO", "CNPJ_INAPTO", "SITUACAO_ALTERADA", "ENDERECO_ALTERADO", "TELEFONE_ALTERADO"},
+	"socios":          {"SOCIO_ENTRADA", "SOCIO_SAIDA"},
+}
+
+// changeQuery é um INSERT ... SELECT que grava em mudancas um tipo de mudança, com o mês de referência
+// da carga em $1.
+type changeQuery struct {
+	desc  string
+	query string
+}
+
+// estabelecimentoChanges são os INSERTs que comparam estabelecimento_staging (n) com estabelecimento (o) por cnpj.
+var estabelecimentoChanges = []changeQuery{
+	{"CNPJs abertos", `
+		INSERT INTO mudancas (referencia, tipo, cnpj, cnpj_basico, uf, cnae_fiscal, valor_novo)
+		SELECT $1, 'CNPJ_ABERTO', n.cnpj, n.cnpj_basico, n.uf, n.cnae_fiscal, n.situacao_cadastral
+		FROM estabelecimento_staging n
+		WHERE NOT EXISTS (SELECT 1 FROM estabelecimento o WHERE o.cnpj = n.cnpj)
+	`},
+	// Algumas exportações perdem o zero à esquerda da situação ("8" em vez de "08"): as duas formas são
+	// comparadas com lpad, para que a baixa seja reconhecida e "8" -> "08" não conte como mudança.
+	{"situações cadastrais", `
+		INSERT INTO mudancas (referencia, tipo, cnpj, cnpj_basico, uf, cnae_fiscal, valor_anterior, valor_novo)
+		SELECT $1,
+			CASE lpad(n.situacao_cadastral, 2, '0') WHEN '08' THEN 'CNPJ_BAIXADO' WHEN '04' THEN 'CNPJ_INAPTO' ELSE 'SITUACAO_ALTERADA' END,
+			n.cnpj, n.cnpj_basico, n.uf, n.cnae_fiscal, o.situacao_cadastral, n.situacao_cadastral
+		FROM estabelecimento_staging n
+		JOIN estabelecimento o ON o.cnpj = n.cnpj
+		WHERE lpad(n.situacao_cadastral, 2, '0') IS DISTINCT FROM lpad(o.situacao_cadastral, 2, '0')
+	`},
+	{"endereços", fmt.Sprintf(`
+		INSERT INTO mudancas (referencia, tipo, cnpj, cnpj_basico, uf, cnae_fiscal, valor_anterior, valor_novo)
+		SELECT $1, 'ENDERECO_ALTERADO', n.cnpj, n.cnpj_basico, n.uf, n.cnae_fiscal, %s, %s
+		FROM estabelecimento_staging n
+		JOIN estabelecimento o ON o.cnpj = n.cnpj
+		WHERE %[1]s IS DISTINCT FROM %[2]s
+	`, fmt.Sprintf(enderecoExpr, "o"), fmt.Sprintf(enderecoExpr, "n"))},
+	{"telefones", fmt.Sprintf(`
+		INSERT INTO mudancas (referencia, tipo, cnpj, cnpj_basico, uf, cnae_fiscal, valor_anterior, valor_novo)
+		SELECT $1, 'TELEFONE_ALTERADO', n.cnpj, n.cnpj_basico, n.uf, n.cnae_fiscal, %s, %s
+		FROM estabelecimento_staging n
+		JOIN estabelecimento o ON o.cnpj = n.cnpj
+		WHERE %[1]s IS DISTINCT FROM %[2]s
+	`, fmt.Sprintf(telefoneExpr, "o"), fmt.Sprintf(telefoneExpr, "n"))},
+}
+
+// socioChanges compara socios_staging (n) com socios (o). Um sócio é identificado por
+// cnpj_basico + cnpj_cpf_socio + nome_socio, já que o CPF vem mascarado pela Receita. CPF e nome são
+// comparados com IS NOT DISTINCT FROM: com =, linhas antigas com NULL nunca se encontrariam e cada carga
+// registraria uma saída e uma entrada falsas.
+// UF e CNAE vêm da matriz em %[1]s (a carga nova de estabelecimento, se houver).
+var socioChanges = []changeQuery{
+	{"entradas de sócios", `
+		INSERT INTO mudancas (referencia, tipo, cnpj, cnpj_basico, uf, cnae_fiscal, valor_novo)
+		SELECT $1, 'SOCIO_ENTRADA', n.cnpj, n.cnpj_basico, m.uf, m.cnae_fiscal, n.nome_socio
+		FROM socios_staging n
+		LEFT JOIN %[1]s m ON m.cnpj = n.cnpj
+		WHERE NOT EXISTS (
+			SELECT 1 FROM socios o
+			WHERE o.cnpj_basico = n.cnpj_basico
+				AND o.cnpj_cpf_socio IS NOT DISTINCT FROM n.cnpj_cpf_socio AND o.nome_socio IS NOT DISTINCT FROM n.nome_socio
+		)
+	`},
+	{"saídas de sócios", `
+		INSERT INTO mudancas (referencia, tipo, cnpj, cnpj_basico, uf, cnae_fiscal, valor_anterior)
+		SELECT $1, 'SOCIO_SAIDA', o.cnpj, o.cnpj_basico, m.uf, m.cnae_fiscal, o.nome_socio
+		FROM socios o
+		LEFT JOIN %[1]s m ON m.cnpj = o.cnpj
+		WHERE NOT EXISTS (
+			SELECT 1 FROM socios_staging n
+			WHERE n.cnpj_basico = o.cnpj_basico
+				AND n.cnpj_cpf_socio IS NOT DISTINCT FROM o.cnpj_cpf_socio AND n.nome_socio IS NOT DISTINCT FROM o.nome_socio
+		)
+	`},
+}
+
+// recordChanges grava em mudancas as diferenças entre a carga nova (_staging) e a geração atual, com o
+// mês de referência ref. Roda dentro da transação de troca, para que o log e os dados novos entrem no ar
+// juntos. Na primeira carga (geração atual vazia) não há com o que comparar e nada é gravado.
+func recordChanges(tx *sqlx.Tx, specs []TableSpec, ref string) error {
+	loaded := map[string]bool{}
+	for _, spec := range specs {
+		loaded[spec.Name] = true
+	}
+
+	if loaded["estabelecimento"] {
+		if err := runChanges(tx, "estabelecimento", estabelecimentoChanges, nil, ref); err != nil {
+			return err
+		}
+	}
+	if loaded["socios"] {
+		matrizes := "estabelecimento"
+		if loaded["estabelecimento"] {
+			matrizes += stagingSuffix
+		}
+		if err := runChanges(tx, "socios", socioChanges, []interface{}{matrizes}, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runChanges executa as comparações de uma tabela, se a geração atual tiver dados. Os eventos de uma carga
+// anterior do mesmo mês são apagados antes, para que refazer a carga não os repita.
+func runChanges(tx *sqlx.Tx, table string, changes []changeQuery, queryArgs []interface{}, ref string) error {
+	if err := clearChanges(tx, table, ref); err != nil {
+		return err
+	}
+
+	var hasRows bool
+	if err := tx.Get(&hasRows, fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s)", table)); err != nil {
+		return fmt.Errorf("erro ao verificar a geração atual de '%s': %w", table, err)
+	}
+	if !hasRows {
+		log.Printf("Tabela '%s' vazia; primeira carga, nenhuma mudança registrada.", table)
+		return nil
+	}
+
+	for _, c := range changes {
+		query := c.query
+		if len(queryArgs) > 0 {
+			query = fmt.Sprintf(query, queryArgs...)
+		}
+		res, err := tx.Exec(query, ref)
+		if err != nil {
+			return fmt.Errorf("erro ao registrar mudanças de %s: %w", c.desc, err)
+		}
+		n, _ := res.RowsAffected()
+		log.Printf("Mudanças de %s registradas: %d", c.desc, n)
+	}
+	return nil
+}
+
+// clearChanges apaga as mudanças de uma tabela registradas pela carga do mês de referência ref.
+func clearChanges(tx *sqlx.Tx, table, ref string) error {
+	tipos, ok := changeTypes[table]
+	if !ok {
+		return nil
+	}
+	res, err := tx.Exec("DELETE FROM mudancas WHERE referencia = $1 AND tipo = ANY($2)", ref, pq.Array(tipos))
+	if err != nil {
+		return fmt.Errorf("erro ao apagar as mudanças de '%s' do dump %s: %w", table, ref, err)
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		log.Printf("Mudanças de '%s' do dump %s apagadas: %d", table, ref, n)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// insertEstabelecimento grava em table um estabelecimento com a situação e o logradouro informados.
+func insertEstabelecimento(t *testing.T, db *sqlx.DB, table, cnpj, situacao, logradouro string) {
+	t.Helper()
+	mustExec(t, db, `
+		INSERT INTO `+table+` (cnpj, cnpj_basico, cnpj_ordem, cnpj_dv, matriz_filial, situacao_cadastral, logradouro, uf)
+		VALUES ($1, $2, $3, $4, '1', $5, $6, 'SP')
+	`, cnpj, cnpj[:8], cnpj[8:12], cnpj[12:], situacao, logradouro)
+}
+
+// insertSocio grava em table um sócio da matriz cnpj; cpf vazio vira NULL.
+func insertSocio(t *testing.T, db *sqlx.DB, table, cnpj, cpf, nome string) {
+	t.Helper()
+	mustExec(t, db, `
+		INSERT INTO `+table+` (cnpj, cnpj_basico, cnpj_cpf_socio, nome_socio)
+		VALUES ($1, $2, NULLIF($3, ''), $4)
+	`, cnpj, cnpj[:8], cpf, nome)
+}
+
+// changesByType conta as mudanças registradas com o mês de referência ref, por tipo.
+func changesByType(t *testing.T, db *sqlx.DB, ref string) map[string]int {
+	t.Helper()
+	var rows []struct {
+		Tipo  string `db:"tipo"`
+		Total int    `db:"total"`
+	}
+	if err := db.Select(&rows, "SELECT tipo, count(*) AS total FROM mudancas WHERE referencia = $1 GROUP BY tipo", ref); err != nil {
+		t.Fatalf("erro ao consultar as mudanças: %v", err)
+	}
+	got := map[string]int{}
+	for _, r := range rows {
+		got[r.Tipo] = r.Total
+	}
+	return got
+}
+
+// recordChangesTx executa recordChanges em uma transação própria, como na troca.
+func recordChangesTx(t *testing.T, db *sqlx.DB, names []string, ref string) {
+	t.Helper()
+	specs, err := selectTables(names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := db.Beginx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	if err := recordChanges(tx, specs, ref); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecordChanges(t *testing.T) {
+	db := testDB(t)
+	imp := NewImporter(db, Options{})
+	for _, table := range []string{"estabelecimento", "socios"} {
+		if err := imp.prepareStaging(table); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	live, staging := "estabelecimento", "estabelecimento"+stagingSuffix
+	insertEstabelecimento(t, db, live, "11111111000111", "02", "RUA A")
+	insertEstabelecimento(t, db, staging, "11111111000111", "8", "RUA A") // Baixa sem o zero à esquerda
+	insertEstabelecimento(t, db, live, "22222222000122", "4", "RUA B")
+	insertEstabelecimento(t, db, staging, "22222222000122", "04", "RUA B") // Só ganhou o zero: não muda
+	insertEstabelecimento(t, db, live, "33333333000133", "02", "RUA C")
+	insertEstabelecimento(t, db, staging, "33333333000133", "02", "RUA D")
+	insertEstabelecimento(t, db, staging, "44444444000144", "02", "RUA E")
+
+	insertSocio(t, db, "socios", "11111111000111", "", "ANA") // Sem CPF nas duas cargas: não muda
+	insertSocio(t, db, "socios"+stagingSuffix, "11111111000111", "", "ANA")
+	insertSocio(t, db, "socios", "11111111000111", "***123456**", "CARLA")
+	insertSocio(t, db, "socios"+stagingSuffix, "11111111000111", "***654321**", "BRUNO")
+
+	want := map[string]int{
+		"CNPJ_BAIXADO":      1,
+		"ENDERECO_ALTERADO": 1,
+		"CNPJ_ABERTO":       1,
+		"SOCIO_ENTRADA":     1,
+		"SOCIO_SAIDA":       1,
+	}
+	// Refazer a carga do mesmo mês substitui os eventos em vez de repeti-los.
+	for i := 0; i < 2; i++ {
+		recordChangesTx(t, db, []string{"estabelecimento", "socios"}, "2025-06")
+		got := changesByType(t, db, "2025-06")
+		if len(got) != len(want) {
+			t.Errorf("execução %d: mudanças = %v, esperado %v", i+1, got, want)
+			continue
+		}
+		for tipo, n := range want {
+			if got[tipo] != n {
+				t.Errorf("execução %d: mudanças = %v, esperado %v", i+1, got, want)
+				break
+			}
+		}
+	}
+}
+
+// loadEstabelecimento simula uma carga de estabelecimento com um único CNPJ na situação informada.
+func loadEstabelecimento(t *testing.T, imp *Importer, ref, situacao string) {
+	t.Helper()
+	spec, _ := FindTable("estabelecimento")
+	for _, table := range spec.generations() {
+		if err := imp.prepareStaging(table); err != nil {
+			t.Fatal(err)
+		}
+	}
+	insertEstabelecimento(t, imp.db, "estabelecimento"+stagingSuffix, "11111111000111", situacao, "RUA A")
+	for _, table := range spec.generations() {
+		if err := imp.buildStagingIndexes(table); err != nil {
+			t.Fatal(err)
+		}
+	}
+	version := &dataVersion{reference: ref, started: time.Now(), rows: map[string]int64{spec.Name: 1}}
+	if err := imp.swapTables([]TableSpec{spec}, version); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRollbackClearsChanges(t *testing.T) {
+	db := testDB(t)
+	imp := NewImporter(db, Options{})
+
+	loadEstabelecimento(t, imp, "2025-05", "02")
+	loadEstabelecimento(t, imp, "2025-06", "08")
+	if got := changesByType(t, db, "2025-06"); got["CNPJ_BAIXADO"] != 1 {
+		t.Fatalf("mudanças de 2025-06 depois da carga = %v, esperado uma CNPJ_BAIXADO", got)
+	}
+
+	if err := imp.Rollback([]string{"estabelecimento"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := changesByType(t, db, "2025-06"); len(got) != 0 {
+		t.Errorf("mudanças de 2025-06 depois do rollback = %v, esperado nenhuma", got)
+	}
+}
@@ -0,0 +1,170 @@
+// neurocloser/backend/importer/checkpoint.go
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// referencePattern é o formato do mês de referência de um dump da Receita (ex.: "2025-06"),
+// o mesmo usado no nome dos diretórios do portal de Dados Abertos.
+var referencePattern = regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`)
+
+// checkpoint é o progresso da carga de um CSV (um arquivo dentro de um zip) em <tabela>_staging.
+// É gravado na mesma transação de cada lote, então reflete exatamente o que já foi confirmado.
+type checkpoint struct {
+	Table     string `db:"tabela"`
+	File      string `db:"arquivo"` // <zip>/<csv>, o mesmo registrado na quarentena
+	Reference string `db:"referencia"`
+	Checksum  string `db:"checksum"` // SHA-256 do zip
+	Rows      int64  `db:"linhas"`   // Registros do CSV já processados (aceitos ou rejeitados)
+	Accepted  int64  `db:"aceitas"`
+	Fixed     int64  `db:"corrigidas"`
+	Rejected  int64  `db:"rejeitadas"`
+	Done      bool   `db:"concluido"`
+}
+
+// referenceMonth retorna o mês de referência do dump: o informado nas opções ou,
+// na falta dele, o nome do diretório (ex.: ./dados/2025-06).
+func (imp *Importer) referenceMonth() (string, error) {
+	ref := imp.opts.Reference
+	if ref == "" {
+		ref = filepath.Base(filepath.Clean(imp.opts.Dir))
+		if !referencePattern.MatchString(ref) {
+			return "", fmt.Errorf("não foi possível deduzir o mês de referência do diretório '%s'; informe-o no formato AAAA-MM", imp.opts.Dir)
+		}
+		return ref, nil
+	}
+	if !referencePattern.MatchString(ref) {
+		return "", fmt.Errorf("mês de referência inválido '%s', esperado AAAA-MM", ref)
+	}
+	return ref, nil
+}
+
+// checkReference impede que uma carga interrompida de um mês seja completada com arquivos de outro.
+// Com a opção Restart, os checkpoints pendentes e a quarentena das cargas que eles acompanhavam são
+// descartados e tudo começa do zero.
+func (imp *Importer) checkReference(ref string) error {
+	if imp.opts.Restart {
+		tx, err := imp.db.Beginx()
+		if err != nil {
+			return fmt.Errorf("erro ao iniciar transação: %w", err)
+		}
+		defer tx.Rollback()
+
+		if _, err := tx.Exec("DELETE FROM quarentena WHERE referencia IN (SELECT referencia FROM importacao_checkpoints)"); err != nil {
+			return fmt.Errorf("erro ao descartar a quarentena: %w", err)
+		}
+		if _, err := tx.Exec("DELETE FROM importacao_checkpoints"); err != nil {
+			return fmt.Errorf("erro ao descartar os checkpoints: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("erro ao confirmar o descarte dos checkpoints: %w", err)
+		}
+		return nil
+	}
+
+	var pending []string
+	if err := imp.db.Select(&pending, "SELECT DISTINCT referencia FROM importacao_checkpoints WHERE referencia <> $1", ref); err != nil {
+		return fmt.Errorf("erro ao consultar os checkpoints: %w", err)
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("há uma importação não concluída do dump %s; termine-a ou descarte-a antes de importar %s", pending[0], ref)
+	}
+	return nil
+}
+
+// openStaging prepara <tabela>_staging e devolve os checkpoints dos arquivos já carregados.
+// Se houver checkpoints e a tabela _staging ainda existir, a carga é retomada de onde parou;
+// caso contrário a tabela é recriada vazia, e os checkpoints antigos da tabela e a quarentena dela no
+// mês de referência ref são descartados.
+func (imp *Importer) openStaging(spec TableSpec, ref string) (map[string]*checkpoint, error) {
+	var cps []*checkpoint
+	query := `
+		SELECT tabela, arquivo, referencia, checksum, linhas, aceitas, corrigidas, rejeitadas, concluido
+		FROM importacao_checkpoints
+		WHERE tabela = $1
+	`
+	if err := imp.db.Select(&cps, query, spec.Name); err != nil {
+		return nil, fmt.Errorf("erro ao consultar os checkpoints de '%s': %w", spec.Name, err)
+	}
+
+	if len(cps) > 0 {
+		var exists bool
+		if err := imp.db.Get(&exists, "SELECT to_regclass($1) IS NOT NULL", spec.Name+stagingSuffix); err != nil {
+			return nil, fmt.Errorf("erro ao verificar a tabela '%s%s': %w", spec.Name, stagingSuffix, err)
+		}
+		if exists {
+			byFile := make(map[string]*checkpoint, len(cps))
+			for _, cp := range cps {
+				byFile[cp.File] = cp
+			}
+			log.Printf("Retomando a carga de '%s' a partir de %d checkpoint(s).", spec.Name, len(cps))
+			return byFile, nil
+		}
+	}
+
+	if _, err := imp.db.Exec("DELETE FROM importacao_checkpoints WHERE tabela = $1", spec.Name); err != nil {
+		return nil, fmt.Errorf("erro ao descartar os checkpoints de '%s': %w", spec.Name, err)
+	}
+	if _, err := imp.db.Exec("DELETE FROM quarentena WHERE tabela = $1 AND referencia = $2", spec.Name, ref); err != nil {
+		return nil, fmt.Errorf("erro ao descartar a quarentena de '%s': %w", spec.Name, err)
+	}
+	if err := imp.prepareStaging(spec.Name); err != nil {
+		return nil, err
+	}
+	return map[string]*checkpoint{}, nil
+}
+
+// saveCheckpoint grava o progresso do arquivo dentro da transação do lote.
+func saveCheckpoint(tx *sqlx.Tx, cp *checkpoint) error {
+	query := `
+		INSERT INTO importacao_checkpoints
+			(tabela, arquivo, referencia, checksum, linhas, aceitas, corrigidas, rejeitadas, concluido)
+		VALUES (:tabela, :arquivo, :referencia, :checksum, :linhas, :aceitas, :corrigidas, :rejeitadas, :concluido)
+		ON CONFLICT (tabela, arquivo) DO UPDATE SET
+			linhas = EXCLUDED.linhas,
+			aceitas = EXCLUDED.aceitas,
+			corrigidas = EXCLUDED.corrigidas,
+			rejeitadas = EXCLUDED.rejeitadas,
+			concluido = EXCLUDED.concluido,
+			atualizado_em = now()
+	`
+	if _, err := tx.NamedExec(query, cp); err != nil {
+		return fmt.Errorf("erro ao gravar o checkpoint de '%s': %w", cp.File, err)
+	}
+	return nil
+}
+
+// clearCheckpoints remove os checkpoints das tabelas colocadas no ar. Roda na transação de troca.
+func clearCheckpoints(tx *sqlx.Tx, specs []TableSpec) error {
+	for _, spec := range specs {
+		if _, err := tx.Exec("DELETE FROM importacao_checkpoints WHERE tabela = $1", spec.Name); err != nil {
+			return fmt.Errorf("erro ao remover os checkpoints de '%s': %w", spec.Name, err)
+		}
+	}
+	return nil
+}
+
+// fileChecksum calcula o SHA-256 do arquivo, em hexadecimal.
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("erro ao abrir '%s': %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("erro ao calcular o checksum de '%s': %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
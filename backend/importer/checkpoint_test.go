@@ -0,0 +1,135 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+func TestReferenceMonth(t *testing.T) {
+	tests := []struct {
+		dir     string
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{"./dados/2025-06", "", "2025-06", false},
+		{"./dados/2025-06/", "", "2025-06", false},
+		{"./dados", "2025-06", "2025-06", false},
+		{"./dados", "", "", true},
+		{"./dados", "2025-13", "", true},
+		{"./dados", "202506", "", true},
+	}
+	for _, tt := range tests {
+		imp := &Importer{opts: Options{Dir: tt.dir, Reference: tt.ref}}
+		got, err := imp.referenceMonth()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("referenceMonth(%q, %q): erro = %v, esperado erro: %v", tt.dir, tt.ref, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("referenceMonth(%q, %q) = %q, esperado %q", tt.dir, tt.ref, got, tt.want)
+		}
+	}
+}
+
+// saveTestCheckpoint grava um checkpoint de cnae com o mês de referência ref.
+func saveTestCheckpoint(t *testing.T, db *sqlx.DB, ref string) {
+	t.Helper()
+	tx, err := db.Beginx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	cp := &checkpoint{Table: "cnae", File: "Cnaes.zip/F.K03200$Z.D50613.CNAECSV", Reference: ref, Checksum: "abc", Rows: 10, Accepted: 10}
+	if err := saveCheckpoint(tx, cp); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// insertQuarentena grava uma linha rejeitada de cnae com o mês de referência ref.
+func insertQuarentena(t *testing.T, db *sqlx.DB, ref string) {
+	t.Helper()
+	mustExec(t, db, `
+		INSERT INTO quarentena (tabela, referencia, arquivo, linha, motivo, conteudo)
+		VALUES ('cnae', $1, 'Cnaes.zip/F.K03200$Z.D50613.CNAECSV', 1, 'campos a menos', 'x')
+	`, ref)
+}
+
+func TestCheckReference(t *testing.T) {
+	db := testDB(t)
+	saveTestCheckpoint(t, db, "2025-05")
+	insertQuarentena(t, db, "2025-05")
+	insertQuarentena(t, db, "2025-04")
+
+	imp := NewImporter(db, Options{})
+	if err := imp.checkReference("2025-06"); err == nil {
+		t.Error("checkReference aceitou outro mês com uma carga pendente de 2025-05")
+	}
+	if err := imp.checkReference("2025-05"); err != nil {
+		t.Errorf("checkReference recusou retomar a carga do mesmo mês: %v", err)
+	}
+
+	imp = NewImporter(db, Options{Restart: true})
+	if err := imp.checkReference("2025-06"); err != nil {
+		t.Fatalf("checkReference com Restart: %v", err)
+	}
+	if n := count(t, db, "SELECT count(*) FROM importacao_checkpoints"); n != 0 {
+		t.Errorf("Restart deixou %d checkpoint(s)", n)
+	}
+	if n := count(t, db, "SELECT count(*) FROM quarentena WHERE referencia = '2025-05'"); n != 0 {
+		t.Errorf("Restart deixou %d linha(s) na quarentena da carga descartada", n)
+	}
+	if n := count(t, db, "SELECT count(*) FROM quarentena WHERE referencia = '2025-04'"); n != 1 {
+		t.Errorf("Restart apagou a quarentena de uma carga já concluída (%d linha(s) restantes)", n)
+	}
+}
+
+func TestOpenStaging(t *testing.T) {
+	db := testDB(t)
+	imp := NewImporter(db, Options{})
+	spec, _ := FindTable("cnae")
+
+	// Com checkpoints e a _staging ainda existente, a carga é retomada.
+	if err := imp.prepareStaging(spec.Name); err != nil {
+		t.Fatal(err)
+	}
+	saveTestCheckpoint(t, db, "2025-06")
+	insertQuarentena(t, db, "2025-06")
+	cps, err := imp.openStaging(spec, "2025-06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cps) != 1 {
+		t.Errorf("openStaging retomou %d checkpoint(s), esperado 1", len(cps))
+	}
+	if n := count(t, db, "SELECT count(*) FROM quarentena WHERE referencia = '2025-06'"); n != 1 {
+		t.Errorf("retomar a carga alterou a quarentena (%d linha(s), esperado 1)", n)
+	}
+
+	// Sem a _staging, a carga recomeça: checkpoints e quarentena do mês são descartados.
+	mustExec(t, db, "DROP TABLE cnae_staging")
+	insertQuarentena(t, db, "2025-05")
+	cps, err = imp.openStaging(spec, "2025-06")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cps) != 0 {
+		t.Errorf("openStaging retomou %d checkpoint(s) sem a tabela _staging", len(cps))
+	}
+	if n := count(t, db, "SELECT count(*) FROM importacao_checkpoints WHERE tabela = 'cnae'"); n != 0 {
+		t.Errorf("recomeçar a carga deixou %d checkpoint(s)", n)
+	}
+	if n := count(t, db, "SELECT count(*) FROM quarentena WHERE referencia = '2025-06'"); n != 0 {
+		t.Errorf("recomeçar a carga deixou %d linha(s) na quarentena do mês", n)
+	}
+	if n := count(t, db, "SELECT count(*) FROM quarentena WHERE referencia = '2025-05'"); n != 1 {
+		t.Errorf("recomeçar a carga apagou a quarentena de outro mês (%d linha(s) restantes)", n)
+	}
+	if n := count(t, db, "SELECT count(*) FROM pg_tables WHERE schemaname = current_schema() AND tablename = 'cnae_staging'"); n != 1 {
+		t.Error("openStaging não recriou cnae_staging")
+	}
+}
@@ -0,0 +1,84 @@
+package importer
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edufilhocruz/neurocloser/backend/database"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// testDB conecta ao PostgreSQL de TEST_DATABASE_URL (um banco descartável) em um schema criado só para o
+// teste, com as migrations aplicadas, e o remove no fim. Sem a variável, o teste é pulado.
+func testDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	connStr := os.Getenv("TEST_DATABASE_URL")
+	if connStr == "" {
+		t.Skip("TEST_DATABASE_URL não configurada; teste de integração pulado")
+	}
+
+	admin, err := sqlx.Connect("postgres", connStr)
+	if err != nil {
+		t.Fatalf("erro ao conectar ao banco de teste: %v", err)
+	}
+	schema := fmt.Sprintf("teste_%d", time.Now().UnixNano())
+	if _, err := admin.Exec("CREATE SCHEMA " + schema); err != nil {
+		admin.Close()
+		t.Fatalf("erro ao criar o schema %s: %v", schema, err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("erro ao remover o schema %s: %v", schema, err)
+		}
+		admin.Close()
+	})
+
+	// As extensões podem já estar instaladas em public; o schema do teste vem antes dele.
+	db, err := sqlx.Connect("postgres", withSearchPath(connStr, schema+",public"))
+	if err != nil {
+		t.Fatalf("erro ao conectar ao schema %s: %v", schema, err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.MigrateUp(db); err != nil {
+		t.Fatalf("erro ao aplicar as migrations: %v", err)
+	}
+	return db
+}
+
+// withSearchPath acrescenta o parâmetro search_path à string de conexão, em formato URL ou chave=valor.
+func withSearchPath(connStr, searchPath string) string {
+	if strings.Contains(connStr, "://") {
+		u, err := url.Parse(connStr)
+		if err == nil {
+			q := u.Query()
+			q.Set("search_path", searchPath)
+			u.RawQuery = q.Encode()
+			return u.String()
+		}
+	}
+	return connStr + " search_path=" + searchPath
+}
+
+// mustExec executa um comando de preparação do teste.
+func mustExec(t *testing.T, db *sqlx.DB, query string, args ...interface{}) {
+	t.Helper()
+	if _, err := db.Exec(query, args...); err != nil {
+		t.Fatalf("erro ao executar %q: %v", query, err)
+	}
+}
+
+// count devolve o resultado de um SELECT count(*).
+func count(t *testing.T, db *sqlx.DB, query string, args ...interface{}) int {
+	t.Helper()
+	var n int
+	if err := db.Get(&n, query, args...); err != nil {
+		t.Fatalf("erro ao executar %q: %v", query, err)
+	}
+	return n
+}
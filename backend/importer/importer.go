@@ -0,0 +1,530 @@
+// neurocloser/backend/importer/importer.go
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Options reúne as configurações de uma importação.
+type Options struct {
+	Dir       string  // Diretório com os arquivos .zip da Receita
+	BatchSize int     // Linhas por transação (um COPY por lote)
+	Workers   int     // Zips processados em paralelo
+	Tolerance float64 // Variação máxima de linhas aceita em relação à geração atual (0.05 = 5%)
+	Reference string  // Mês de referência do dump (AAAA-MM); se vazio, é o nome do diretório
+	Restart   bool    // Descarta os checkpoints de uma carga interrompida em vez de retomá-la
+	// Progress recebe os eventos de progresso (ex.: NewProgressNotifier). É chamado pelos workers em paralelo.
+	Progress func(models.ProgressoImportacao)
+}
+
+// Importer carrega os arquivos dos Dados Abertos CNPJ da Receita Federal no PostgreSQL.
+type Importer struct {
+	db   *sqlx.DB
+	opts Options
+}
+
+// NewImporter cria um novo Importer com as opções informadas.
+func NewImporter(db *sqlx.DB, opts Options) *Importer {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 50000
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	return &Importer{db: db, opts: opts}
+}
+
+// Run importa as tabelas informadas (ou todas, se a lista estiver vazia), na ordem de Tables.
+// Cada tabela é carregada em <tabela>_staging, indexada e conferida; só depois que todas passam
+// nas verificações elas entram no ar juntas, em uma única transação (ver swapTables).
+// Enquanto isso, o servidor GraphQL continua consultando a geração atual.
+// Linhas inválidas não interrompem a carga: vão para a tabela quarentena e entram no Report.
+// O progresso de cada arquivo fica em importacao_checkpoints; se a execução cair, rodar de novo
+// com o mesmo dump continua do último lote confirmado.
+func (imp *Importer) Run(names []string) (Report, error) {
+	specs, err := selectTables(names)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := imp.referenceMonth()
+	if err != nil {
+		return nil, err
+	}
+	if err := imp.checkReference(ref); err != nil {
+		return nil, err
+	}
+
+	report, err := imp.run(specs, ref)
+	if err != nil {
+		msg := err.Error()
+		imp.emit(models.ProgressoImportacao{Referencia: ref, Fase: PhaseFailed, Mensagem: &msg})
+		return report, err
+	}
+	imp.emit(models.ProgressoImportacao{Referencia: ref, Fase: PhaseDone})
+	return report, nil
+}
+
+// run executa as fases da importação: carga, índices e verificações, e troca.
+func (imp *Importer) run(specs []TableSpec, ref string) (Report, error) {
+	version := &dataVersion{reference: ref, started: time.Now(), rows: map[string]int64{}}
+	var report Report
+	loaded := map[string]bool{}
+	progress := map[string]*tableProgress{}
+	for _, spec := range specs {
+		start := time.Now()
+		cps, err := imp.openStaging(spec, ref)
+		if err != nil {
+			return report, err
+		}
+		tr := report.tableReport(spec.Name)
+		tp := &tableProgress{spec: spec, ref: ref, report: tr, start: start}
+		progress[spec.Name] = tp
+		if err := imp.importTable(tp, cps); err != nil {
+			return report, err
+		}
+		loaded[spec.Name] = true
+		tr.Elapsed = time.Since(start)
+		log.Printf("Tabela '%s' carregada: %d linhas (%d corrigidas, %d rejeitadas) em %v (%.0f linhas/s)",
+			spec.Name, tr.Accepted+tr.Fixed, tr.Fixed, tr.Rejected, tr.Elapsed.Round(time.Second), tr.Throughput())
+	}
+
+	if loaded["socios"] {
+		if err := imp.fillSociosCNPJ(loaded["estabelecimento"]); err != nil {
+			return report, err
+		}
+	}
+	if loaded["estabelecimento"] {
+		spec, _ := FindTable("estabelecimento")
+		if err := imp.fillBusca(spec, loaded["empresas"], loaded["cnae"]); err != nil {
+			return report, err
+		}
+		if err := imp.fillCNAESecundaria(); err != nil {
+			return report, err
+		}
+	}
+
+	for _, spec := range specs {
+		imp.emit(progress[spec.Name].event(PhaseIndex, ""))
+		for _, table := range spec.generations() {
+			if err := imp.buildStagingIndexes(table); err != nil {
+				return report, err
+			}
+		}
+		rows, err := imp.checkRowCount(spec)
+		if err != nil {
+			return report, err
+		}
+		version.rows[spec.Name] = rows
+	}
+
+	imp.emit(models.ProgressoImportacao{Referencia: ref, Fase: PhaseSwap})
+	if err := imp.swapTables(specs, version); err != nil {
+		return report, err
+	}
+	log.Printf("Dump %s: %d tabela(s) colocadas no ar; a geração anterior ficou nas tabelas *%s.", ref, len(specs), previousSuffix)
+	return report, nil
+}
+
+// selectTables valida os nomes informados e os devolve na ordem de carga de Tables.
+func selectTables(names []string) ([]TableSpec, error) {
+	if len(names) == 0 {
+		return Tables, nil
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		if _, ok := FindTable(name); !ok {
+			return nil, fmt.Errorf("tabela desconhecida '%s'", name)
+		}
+		wanted[name] = true
+	}
+
+	var specs []TableSpec
+	for _, t := range Tables {
+		if wanted[t.Name] {
+			specs = append(specs, t)
+		}
+	}
+	return specs, nil
+}
+
+// importTable carrega todos os zips correspondentes na tabela <tabela>_staging.
+// Os zips são processados em paralelo por até Workers goroutines, cada uma com sua própria transação por lote.
+// Arquivos já concluídos em uma execução anterior são pulados; os interrompidos continuam
+// do checkpoint, desde que o zip seja o mesmo (mesmo checksum). O primeiro erro interrompe os demais.
+func (imp *Importer) importTable(tp *tableProgress, cps map[string]*checkpoint) error {
+	spec := tp.spec
+	zips, err := findZips(imp.opts.Dir, spec.ZipPrefix)
+	if err != nil {
+		return err
+	}
+	if len(zips) == 0 {
+		return fmt.Errorf("nenhum arquivo '%s*.zip' encontrado em '%s'", spec.ZipPrefix, imp.opts.Dir)
+	}
+	for _, zipPath := range zips {
+		size, err := zipSize(zipPath)
+		if err != nil {
+			return err
+		}
+		tp.total += size
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	jobs := make(chan string)
+	for i := 0; i < min(imp.opts.Workers, len(zips)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for zipPath := range jobs {
+				if err := imp.importZip(ctx, tp, zipPath, cps); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+send:
+	for _, zipPath := range zips {
+		select {
+		case jobs <- zipPath:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return firstErr
+}
+
+// importZip carrega os CSVs de um zip, retomando de cada checkpoint.
+// cps é só lido aqui: cada arquivo pertence a um único zip, e portanto a uma única goroutine.
+func (imp *Importer) importZip(ctx context.Context, tp *tableProgress, zipPath string, cps map[string]*checkpoint) error {
+	spec := tp.spec
+	imp.emit(tp.event(PhaseParse, filepath.Base(zipPath)))
+	checksum, err := fileChecksum(zipPath)
+	if err != nil {
+		return err
+	}
+	sources, closeAll, err := openZipCSVs(zipPath, spec.Fields, &tp.read)
+	if err != nil {
+		return err
+	}
+	defer closeAll()
+
+	for _, src := range sources {
+		file := filepath.Base(src.zipPath) + "/" + src.name
+		cp, ok := cps[file]
+		if !ok {
+			cp = &checkpoint{Table: spec.Name, File: file, Reference: tp.ref, Checksum: checksum}
+		} else if cp.Checksum != checksum {
+			return fmt.Errorf("o arquivo '%s' mudou desde a carga interrompida (checksum diferente); descarte os checkpoints para recomeçar", file)
+		}
+		tp.report.add(cp.Accepted, cp.Fixed, cp.Rejected)
+		if cp.Done {
+			log.Printf("%s já foi carregado em '%s', pulando.", file, spec.Name)
+			// O arquivo não será lido; conta como pulado no cálculo da estimativa.
+			tp.read.Add(src.size)
+			tp.skipped.Add(src.size)
+			continue
+		}
+
+		if cp.Rows > 0 {
+			log.Printf("Retomando %s em '%s' após %d linhas...", file, spec.Name, cp.Rows)
+		} else {
+			log.Printf("Importando %s para '%s'...", file, spec.Name)
+		}
+		start := time.Now()
+		resumedAt := cp.Rows
+		if err := imp.loadCSV(ctx, tp, src, cp); err != nil {
+			return err
+		}
+		elapsed := time.Since(start)
+		log.Printf("%s carregado em '%s': %d linhas em %v (%.0f linhas/s)",
+			file, spec.Name, cp.Rows-resumedAt, elapsed.Round(time.Second), rate(cp.Rows-resumedAt, elapsed))
+	}
+	return nil
+}
+
+// loadCSV lê o CSV linha a linha e envia os registros em lotes de BatchSize, um lote por transação.
+// Cada linha passa pelos validadores da tabela; as rejeitadas vão para a quarentena no mesmo lote.
+// As primeiras cp.Rows linhas já foram confirmadas em uma execução anterior e são apenas lidas.
+func (imp *Importer) loadCSV(ctx context.Context, tp *tableProgress, src *csvSource, cp *checkpoint) error {
+	spec, report := tp.spec, tp.report
+	file := cp.File
+	for skipped := int64(0); skipped < cp.Rows; skipped++ {
+		_, err := src.reader.Read()
+		if err == io.EOF {
+			return fmt.Errorf("'%s' tem menos linhas (%d) que o checkpoint (%d)", file, skipped, cp.Rows)
+		}
+		var parseErr *csv.ParseError
+		if err != nil && !errors.As(err, &parseErr) {
+			return fmt.Errorf("erro ao ler '%s': %w", src.name, err)
+		}
+	}
+	tp.skipped.Add(src.counter.file)
+
+	batch := make([][]interface{}, 0, imp.opts.BatchSize)
+	var rejected []rejectedRow
+	var fixed int64
+
+	flush := func(done bool) error {
+		// Outro worker falhou: não adianta continuar, o checkpoint já guarda o que foi confirmado.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		progress := *cp
+		progress.Rows += int64(len(batch) + len(rejected))
+		progress.Fixed += fixed
+		progress.Accepted += int64(len(batch)) - fixed
+		progress.Rejected += int64(len(rejected))
+		progress.Done = done
+		if err := imp.insertBatch(spec, batch, rejected, &progress); err != nil {
+			return err
+		}
+		*cp = progress
+		report.add(int64(len(batch))-fixed, fixed, int64(len(rejected)))
+		report.addProcessed(int64(len(batch) + len(rejected)))
+		batch, rejected, fixed = batch[:0], rejected[:0], 0
+		imp.emit(tp.event(PhaseLoad, file))
+		return nil
+	}
+
+	for {
+		record, err := src.reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		// Aspas quebradas costumam aparecer como quantidade errada de campos; a linha vai para a quarentena.
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rejected = append(rejected, rejectedRow{file, parseErr.StartLine, parseErr.Err.Error(), strings.Join(record, ";")})
+		} else if err != nil {
+			return fmt.Errorf("erro ao ler '%s': %w", src.name, err)
+		} else {
+			line, _ := src.reader.FieldPos(0)
+			rowFixed, err := validateRow(spec.Name, record)
+			var values []interface{}
+			if err == nil {
+				values, err = spec.Convert(record)
+			}
+			if err != nil {
+				rejected = append(rejected, rejectedRow{file, line, err.Error(), strings.Join(record, ";")})
+			} else {
+				batch = append(batch, values)
+				if rowFixed {
+					fixed++
+				}
+			}
+		}
+
+		if len(batch)+len(rejected) >= imp.opts.BatchSize {
+			if err := flush(false); err != nil {
+				return err
+			}
+		}
+	}
+
+	// O último lote (mesmo vazio) marca o arquivo como concluído.
+	return flush(true)
+}
+
+// insertBatch grava um lote de linhas, e as rejeitadas do mesmo trecho na quarentena, com COPY
+// dentro de uma única transação. O checkpoint do arquivo é atualizado na mesma transação.
+func (imp *Importer) insertBatch(spec TableSpec, batch [][]interface{}, rejected []rejectedRow, cp *checkpoint) error {
+	tx, err := imp.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação para '%s': %w", spec.Name, err)
+	}
+	defer tx.Rollback()
+
+	// Dados e checkpoint são confirmados juntos; se o servidor cair antes do fsync, os dois se perdem
+	// juntos e o lote é refeito na próxima execução. Não é preciso esperar o disco a cada lote.
+	if _, err := tx.Exec("SET LOCAL synchronous_commit = off"); err != nil {
+		return fmt.Errorf("erro ao configurar synchronous_commit: %w", err)
+	}
+
+	if err := copyRows(tx, spec.Name+stagingSuffix, spec.Columns, batch); err != nil {
+		return fmt.Errorf("erro ao copiar o lote para '%s': %w", spec.Name, err)
+	}
+
+	if len(rejected) > 0 {
+		rows := make([][]interface{}, len(rejected))
+		for i, r := range rejected {
+			rows[i] = r.values(spec.Name, cp.Reference)
+		}
+		if err := copyRows(tx, "quarentena", quarentenaColumns, rows); err != nil {
+			return fmt.Errorf("erro ao inserir na quarentena: %w", err)
+		}
+	}
+
+	if err := saveCheckpoint(tx, cp); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("erro ao confirmar lote em '%s': %w", spec.Name, err)
+	}
+	return nil
+}
+
+// copyRows envia as linhas com COPY ... FROM STDIN (pq.CopyIn), muito mais rápido que um INSERT por linha.
+func copyRows(tx *sqlx.Tx, table string, columns []string, rows [][]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	stmt, err := tx.Prepare(pq.CopyIn(table, columns...))
+	if err != nil {
+		return err
+	}
+	for _, values := range rows {
+		if _, err := stmt.Exec(values...); err != nil {
+			stmt.Close()
+			return err
+		}
+	}
+	// Exec sem argumentos envia o fim do COPY e devolve os erros de constraint, se houver.
+	if _, err := stmt.Exec(); err != nil {
+		stmt.Close()
+		return err
+	}
+	return stmt.Close()
+}
+
+// rate calcula linhas por segundo.
+func rate(rows int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(rows) / elapsed.Seconds()
+}
+
+// fillSociosCNPJ preenche socios_staging.cnpj com o CNPJ da matriz, já que o arquivo de sócios só traz
+// o CNPJ básico. Se os estabelecimentos também foram carregados nesta execução, usa a carga nova.
+func (imp *Importer) fillSociosCNPJ(estabelecimentoStaging bool) error {
+	estabelecimento := "estabelecimento"
+	if estabelecimentoStaging {
+		estabelecimento += stagingSuffix
+	}
+	query := fmt.Sprintf(`
+		UPDATE socios%s s
+		SET cnpj = e.cnpj
+		FROM %s e
+		WHERE e.cnpj_basico = s.cnpj_basico AND e.matriz_filial = '1'
+	`, stagingSuffix, estabelecimento)
+	if _, err := imp.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao preencher o CNPJ dos sócios: %w", err)
+	}
+	return nil
+}
+
+// fillBusca preenche estabelecimento_staging.busca, o documento da busca textual (ver busca_documento na
+// migration 0006_busca_textual), com a razão social e a descrição do CNAE principal. Empresas e CNAEs
+// carregados nesta execução vêm das tabelas _staging. Uma carga só de empresas ou de cnae não atualiza a
+// busca da geração viva: ela é refeita na próxima carga de estabelecimentos.
+// Um UPDATE gravaria de novo cada linha da carga e deixaria a _staging com metade de tuplas mortas; em vez
+// disso as linhas são copiadas, já com a busca, para uma tabela nova que substitui a _staging.
+func (imp *Importer) fillBusca(spec TableSpec, empresasStaging, cnaeStaging bool) error {
+	empresas, cnae := "empresas", "cnae"
+	if empresasStaging {
+		empresas += stagingSuffix
+	}
+	if cnaeStaging {
+		cnae += stagingSuffix
+	}
+	staging, filled := spec.Name+stagingSuffix, spec.Name+"_busca"
+
+	columns := append([]string{"id"}, spec.Columns...)
+	selected := make([]string, len(columns))
+	for i, col := range columns {
+		selected[i] = "e." + col
+	}
+
+	// A tabela de uma execução interrompida no meio do INSERT é descartada.
+	if _, err := imp.db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", filled)); err != nil {
+		return fmt.Errorf("erro ao remover a tabela '%s': %w", filled, err)
+	}
+	query := fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)", filled, spec.Name)
+	if _, err := imp.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao criar a tabela '%s': %w", filled, err)
+	}
+
+	// As tabelas _staging ainda não têm índices; com os JOINs o PostgreSQL pode usar hash join. Empresas e
+	// CNAEs repetidos na carga duplicariam estabelecimentos aqui, mas também impedem a criação da chave
+	// primária em buildStagingIndexes, e a importação falha antes da troca.
+	query = fmt.Sprintf(`
+		INSERT INTO %s (%s, busca)
+		SELECT %s, busca_documento(emp.razao_social, e.nome_fantasia, c.descricao)
+		FROM %s e
+		LEFT JOIN %s emp ON emp.cnpj_basico = e.cnpj_basico
+		LEFT JOIN %s c ON c.codigo = e.cnae_fiscal
+	`, filled, strings.Join(columns, ", "), strings.Join(selected, ", "), staging, empresas, cnae)
+	if _, err := imp.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao preencher a busca textual dos estabelecimentos: %w", err)
+	}
+
+	tx, err := imp.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação para '%s': %w", staging, err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(fmt.Sprintf("DROP TABLE %s", staging)); err != nil {
+		return fmt.Errorf("erro ao remover a tabela '%s': %w", staging, err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s RENAME TO %s", filled, staging)); err != nil {
+		return fmt.Errorf("erro ao renomear a tabela '%s': %w", filled, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("erro ao substituir a tabela '%s': %w", staging, err)
+	}
+	return nil
+}
+
+// fillCNAESecundaria recria estabelecimento_cnae_secundaria_staging com os códigos da coluna
+// cnae_fiscal_secundaria da carga nova, um por linha e na ordem da lista (ver a migration 0008_cnae_secundaria).
+// Códigos repetidos na lista entram uma vez só, na primeira posição.
+func (imp *Importer) fillCNAESecundaria() error {
+	if err := imp.prepareStaging("estabelecimento_cnae_secundaria"); err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO estabelecimento_cnae_secundaria%s (cnpj, cnae, ordem)
+		SELECT e.cnpj, c.cnae, min(c.ordem)
+		FROM estabelecimento%s e
+		CROSS JOIN LATERAL (
+			SELECT trim(u.codigo) AS cnae, u.ordem
+			FROM unnest(string_to_array(e.cnae_fiscal_secundaria, ',')) WITH ORDINALITY AS u(codigo, ordem)
+		) c
+		WHERE c.cnae <> ''
+		GROUP BY e.cnpj, c.cnae
+	`, stagingSuffix, stagingSuffix)
+	if _, err := imp.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao preencher os CNAEs secundários dos estabelecimentos: %w", err)
+	}
+	return nil
+}
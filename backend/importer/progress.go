@@ -0,0 +1,192 @@
+// neurocloser/backend/importer/progress.go
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Fases de uma importação, na ordem em que acontecem (ver FaseImportacao no schema GraphQL).
+const (
+	PhaseParse  = "PARSE"
+	PhaseLoad   = "LOAD"
+	PhaseIndex  = "INDEX"
+	PhaseSwap   = "SWAP"
+	PhaseDone   = "DONE"
+	PhaseFailed = "FAILED"
+)
+
+// progressChannel é o canal de LISTEN/NOTIFY por onde o importador publica o progresso.
+const progressChannel = "importacao_progresso"
+
+// progressInterval limita a frequência dos eventos LOAD de cada arquivo; as mudanças de fase saem sempre.
+const progressInterval = time.Second
+
+// emit envia um evento para Options.Progress, se configurado.
+func (imp *Importer) emit(ev models.ProgressoImportacao) {
+	if imp.opts.Progress == nil {
+		return
+	}
+	ev.Em = time.Now().UTC().Format(time.RFC3339)
+	imp.opts.Progress(ev)
+}
+
+// tableProgress acompanha a carga de uma tabela para os eventos de progresso. A estimativa de término
+// usa os bytes descompactados lidos dos zips, já que a quantidade de linhas só é conhecida no fim.
+type tableProgress struct {
+	spec   TableSpec
+	ref    string
+	report *TableReport
+
+	start   time.Time
+	total   int64        // Tamanho descompactado de todos os CSVs da tabela
+	read    atomic.Int64 // Bytes já lidos (inclusive os pulados ao retomar um checkpoint)
+	skipped atomic.Int64 // Bytes pulados ao retomar, que não entram no cálculo da velocidade
+}
+
+// event monta um evento da tabela com as contagens e a estimativa atuais.
+func (tp *tableProgress) event(phase, file string) models.ProgressoImportacao {
+	tp.report.mu.Lock()
+	processed := tp.report.Accepted + tp.report.Fixed + tp.report.Rejected
+	rejected := tp.report.Rejected
+	tp.report.mu.Unlock()
+
+	table := tp.spec.Name
+	ev := models.ProgressoImportacao{
+		Referencia:        tp.ref,
+		Fase:              phase,
+		Tabela:            &table,
+		LinhasProcessadas: int(processed),
+		Rejeitadas:        int(rejected),
+	}
+	if file != "" {
+		ev.Arquivo = &file
+	}
+
+	read, loaded := tp.read.Load(), tp.read.Load()-tp.skipped.Load()
+	if loaded > 0 && tp.total > read {
+		elapsed := time.Since(tp.start)
+		eta := int(time.Duration(float64(elapsed) * float64(tp.total-read) / float64(loaded)).Seconds())
+		ev.EtaSegundos = &eta
+	}
+	return ev
+}
+
+// countingReader conta os bytes lidos de um arquivo, somando-os também ao total da tabela.
+type countingReader struct {
+	r     io.Reader
+	file  int64 // Só é lido pela goroutine que lê o arquivo
+	table *atomic.Int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.file += int64(n)
+	c.table.Add(int64(n))
+	return n, err
+}
+
+// NewProgressNotifier cria uma função para Options.Progress que publica os eventos com pg_notify,
+// para que o servidor GraphQL os repasse às assinaturas (ver ProgressBroker).
+// Os eventos LOAD de um mesmo arquivo são limitados a um por progressInterval.
+func NewProgressNotifier(db *sqlx.DB) func(models.ProgressoImportacao) {
+	var mu sync.Mutex
+	lastLoad := map[string]time.Time{}
+
+	return func(ev models.ProgressoImportacao) {
+		if ev.Fase == PhaseLoad && ev.Arquivo != nil {
+			mu.Lock()
+			if time.Since(lastLoad[*ev.Arquivo]) < progressInterval {
+				mu.Unlock()
+				return
+			}
+			lastLoad[*ev.Arquivo] = time.Now()
+			mu.Unlock()
+		}
+
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			log.Printf("Erro ao serializar o progresso da importação: %v", err)
+			return
+		}
+		if _, err := db.Exec("SELECT pg_notify($1, $2)", progressChannel, string(payload)); err != nil {
+			log.Printf("Erro ao publicar o progresso da importação: %v", err)
+		}
+	}
+}
+
+// ProgressBroker escuta o canal de progresso do importador (LISTEN) e distribui os eventos
+// entre as assinaturas GraphQL abertas. Assinantes lentos perdem eventos em vez de travar os demais.
+type ProgressBroker struct {
+	mu   sync.Mutex
+	subs map[chan *models.ProgressoImportacao]struct{}
+}
+
+// NewProgressBroker abre a conexão de LISTEN e começa a distribuir os eventos.
+// A conexão é refeita automaticamente pelo pq.Listener se cair.
+func NewProgressBroker(connStr string) (*ProgressBroker, error) {
+	listener := pq.NewListener(connStr, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Erro na conexão de progresso da importação: %v", err)
+		}
+	})
+	if err := listener.Listen(progressChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	b := &ProgressBroker{subs: map[chan *models.ProgressoImportacao]struct{}{}}
+	go b.run(listener)
+	return b, nil
+}
+
+// run lê as notificações e as repassa aos assinantes.
+func (b *ProgressBroker) run(listener *pq.Listener) {
+	for n := range listener.Notify {
+		// n == nil indica que a conexão foi refeita; eventos nesse intervalo se perderam.
+		if n == nil {
+			continue
+		}
+		var ev models.ProgressoImportacao
+		if err := json.Unmarshal([]byte(n.Extra), &ev); err != nil {
+			log.Printf("Evento de progresso inválido: %v", err)
+			continue
+		}
+
+		b.mu.Lock()
+		for ch := range b.subs {
+			select {
+			case ch <- &ev:
+			default:
+			}
+		}
+		b.mu.Unlock()
+	}
+}
+
+// Subscribe registra uma assinatura, encerrada (e seu canal fechado) quando ctx termina.
+func (b *ProgressBroker) Subscribe(ctx context.Context) <-chan *models.ProgressoImportacao {
+	ch := make(chan *models.ProgressoImportacao, 16)
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, ch)
+		b.mu.Unlock()
+		close(ch)
+	}()
+	return ch
+}
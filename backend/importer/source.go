@@ -0,0 +1,152 @@
+// neurocloser/backend/importer/source.go
+package importer
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// findZips lista, em ordem alfabética, os arquivos .zip do diretório cujo nome começa com o prefixo
+// informado (ex.: "Estabelecimentos" encontra Estabelecimentos0.zip ... Estabelecimentos9.zip).
+func findZips(dir, prefix string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar o diretório '%s': %w", dir, err)
+	}
+
+	var zips []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(name), ".zip") {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+			zips = append(zips, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(zips)
+	return zips, nil
+}
+
+// csvSource é um CSV da Receita aberto diretamente de dentro do .zip, sem extração para o disco.
+type csvSource struct {
+	zipPath string
+	name    string
+	size    int64 // Tamanho descompactado
+	rc      io.ReadCloser
+	counter *countingReader
+	reader  *csv.Reader
+}
+
+// zipSize soma o tamanho descompactado dos arquivos do .zip, lido do diretório central sem descompactar nada.
+func zipSize(zipPath string) (int64, error) {
+	zr, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao abrir o arquivo zip '%s': %w", zipPath, err)
+	}
+	defer zr.Close()
+
+	var size int64
+	for _, f := range zr.File {
+		size += int64(f.UncompressedSize64)
+	}
+	return size, nil
+}
+
+// openZipCSVs abre todos os arquivos contidos no .zip. Cada zip da Receita traz um único CSV,
+// mas tratamos uma lista para não depender disso. Os bytes lidos são somados em read.
+func openZipCSVs(zipPath string, fields int, read *atomic.Int64) ([]*csvSource, func() error, error) {
+	zr, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao abrir o arquivo zip '%s': %w", zipPath, err)
+	}
+
+	var sources []*csvSource
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			zr.Close()
+			return nil, nil, fmt.Errorf("erro ao abrir '%s' dentro de '%s': %w", f.Name, zipPath, err)
+		}
+		counter := &countingReader{r: rc, table: read}
+		sources = append(sources, &csvSource{
+			zipPath: zipPath,
+			name:    f.Name,
+			size:    int64(f.UncompressedSize64),
+			rc:      rc,
+			counter: counter,
+			reader:  newReceitaCSVReader(counter, fields),
+		})
+	}
+
+	closeAll := func() error {
+		for _, s := range sources {
+			s.rc.Close()
+		}
+		return zr.Close()
+	}
+	return sources, closeAll, nil
+}
+
+// newReceitaCSVReader configura um csv.Reader para o layout dos Dados Abertos CNPJ:
+// ISO-8859-1, separado por ponto e vírgula, todos os campos entre aspas.
+func newReceitaCSVReader(r io.Reader, fields int) *csv.Reader {
+	reader := csv.NewReader(newLatin1Reader(r))
+	reader.Comma = ';'
+	reader.FieldsPerRecord = fields
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+	return reader
+}
+
+// latin1Reader converte um fluxo ISO-8859-1 em UTF-8 sob demanda.
+// Em ISO-8859-1 cada byte corresponde exatamente ao code point Unicode de mesmo valor.
+type latin1Reader struct {
+	r   io.Reader
+	buf []byte
+	out []byte
+}
+
+func newLatin1Reader(r io.Reader) *latin1Reader {
+	return &latin1Reader{r: r, buf: make([]byte, 32*1024)}
+}
+
+func (l *latin1Reader) Read(p []byte) (int, error) {
+	for len(l.out) == 0 {
+		n, err := l.r.Read(l.buf)
+		if n == 0 {
+			if err == nil {
+				continue
+			}
+			return 0, err
+		}
+		out := l.out[:0]
+		for _, b := range l.buf[:n] {
+			if b < utf8.RuneSelf {
+				out = append(out, b)
+			} else {
+				out = utf8.AppendRune(out, rune(b))
+			}
+		}
+		l.out = out
+	}
+	n := copy(p, l.out)
+	l.out = l.out[n:]
+	return n, nil
+}
+
+// cleanField remove espaços nas pontas e bytes NUL, que o PostgreSQL não aceita em colunas text.
+func cleanField(s string) string {
+	return strings.TrimSpace(strings.ReplaceAll(s, "\x00", ""))
+}
@@ -0,0 +1,320 @@
+// neurocloser/backend/importer/swap.go
+package importer
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Sufixos das gerações de cada tabela. A geração "viva" (sem sufixo) é a consultada pelo servidor GraphQL;
+// a carga acontece em <tabela>_staging e a geração anterior fica em <tabela>_old para rollback rápido.
+const (
+	liveSuffix     = ""
+	stagingSuffix  = "_staging"
+	previousSuffix = "_old"
+)
+
+// swapLockTimeout limita quanto tempo a troca espera pelos locks, para não enfileirar as consultas do servidor.
+const swapLockTimeout = "30s"
+
+// indexMaintenanceMem é a memória usada na criação de cada índice. Como os índices só são criados
+// depois da carga, cada um é construído de uma vez, ordenando em memória o quanto couber.
+const indexMaintenanceMem = "1GB"
+
+// indexDefPattern separa nome do índice e tabela na saída de pg_get_indexdef.
+var indexDefPattern = regexp.MustCompile(`^(CREATE (?:UNIQUE )?INDEX )(\S+)( ON (?:ONLY )?)(\S+)( .*)$`)
+
+// tableIndex é um índice existente na geração viva de uma tabela.
+type tableIndex struct {
+	Name    string `db:"name"`
+	Def     string `db:"def"`
+	Primary bool   `db:"is_primary"`
+}
+
+// prepareStaging recria <tabela>_staging vazia, com as mesmas colunas e defaults da tabela viva, mas sem índices.
+// Os índices são criados só depois da carga (ver buildStagingIndexes).
+func (imp *Importer) prepareStaging(table string) error {
+	staging := table + stagingSuffix
+	if _, err := imp.db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", staging)); err != nil {
+		return fmt.Errorf("erro ao remover a tabela '%s': %w", staging, err)
+	}
+	query := fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)", staging, table)
+	if _, err := imp.db.Exec(query); err != nil {
+		return fmt.Errorf("erro ao criar a tabela '%s': %w", staging, err)
+	}
+	return nil
+}
+
+// listIndexes retorna os índices de uma tabela, com a definição gerada pelo próprio PostgreSQL.
+func listIndexes(q sqlx.Queryer, table string) ([]tableIndex, error) {
+	var indexes []tableIndex
+	query := `
+		SELECT c.relname AS name, pg_get_indexdef(i.indexrelid) AS def, i.indisprimary AS is_primary
+		FROM pg_index i
+		JOIN pg_class c ON c.oid = i.indexrelid
+		WHERE i.indrelid = $1::regclass
+		ORDER BY c.relname
+	`
+	if err := sqlx.Select(q, &indexes, query, table); err != nil {
+		return nil, fmt.Errorf("erro ao listar os índices de '%s': %w", table, err)
+	}
+	return indexes, nil
+}
+
+// buildStagingIndexes recria em <tabela>_staging todos os índices da tabela viva e atualiza as estatísticas.
+// Os índices recebem o sufixo _staging e só ganham o nome definitivo na troca.
+// Índices que já existem em _staging (execução retomada depois de uma queda) não são recriados.
+func (imp *Importer) buildStagingIndexes(table string) error {
+	staging := table + stagingSuffix
+	indexes, err := listIndexes(imp.db, table)
+	if err != nil {
+		return err
+	}
+	existing, err := listIndexes(imp.db, staging)
+	if err != nil {
+		return err
+	}
+	built := map[string]tableIndex{}
+	for _, idx := range existing {
+		built[idx.Name] = idx
+	}
+
+	for _, idx := range indexes {
+		if b, ok := built[idx.Name+stagingSuffix]; ok && b.Primary == idx.Primary {
+			continue
+		}
+
+		// pg_get_indexdef devolve algo como "CREATE INDEX nome ON public.tabela USING btree (coluna)".
+		m := indexDefPattern.FindStringSubmatch(idx.Def)
+		if m == nil {
+			return fmt.Errorf("definição de índice não reconhecida: %s", idx.Def)
+		}
+		def := m[1] + m[2] + stagingSuffix + m[3] + m[4] + stagingSuffix + m[5]
+
+		if _, ok := built[idx.Name+stagingSuffix]; !ok {
+			log.Printf("Criando índice %s%s...", idx.Name, stagingSuffix)
+			start := time.Now()
+			if err := imp.createIndex(def); err != nil {
+				return fmt.Errorf("erro ao criar o índice '%s%s': %w", idx.Name, stagingSuffix, err)
+			}
+			log.Printf("Índice %s%s criado em %v", idx.Name, stagingSuffix, time.Since(start).Round(time.Second))
+		}
+		if idx.Primary {
+			query := fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY USING INDEX %s%s", staging, idx.Name, stagingSuffix)
+			if _, err := imp.db.Exec(query); err != nil {
+				return fmt.Errorf("erro ao criar a chave primária de '%s': %w", staging, err)
+			}
+		}
+	}
+
+	if _, err := imp.db.Exec(fmt.Sprintf("ANALYZE %s", staging)); err != nil {
+		return fmt.Errorf("erro ao analisar a tabela '%s': %w", staging, err)
+	}
+	return nil
+}
+
+// createIndex executa o CREATE INDEX em uma transação com maintenance_work_mem ampliado.
+func (imp *Importer) createIndex(def string) error {
+	tx, err := imp.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(fmt.Sprintf("SET LOCAL maintenance_work_mem = '%s'", indexMaintenanceMem)); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(def); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// checkRowCount compara a quantidade de linhas da carga nova com a da geração viva e a devolve.
+// Se a variação passar da tolerância (ex.: 0.05 = 5%), a troca é abortada.
+// Uma tabela viva vazia (primeira carga) não é verificada.
+func (imp *Importer) checkRowCount(spec TableSpec) (int64, error) {
+	var live, staging int64
+	if err := imp.db.Get(&live, fmt.Sprintf("SELECT COUNT(*) FROM %s", spec.Name)); err != nil {
+		return 0, fmt.Errorf("erro ao contar as linhas de '%s': %w", spec.Name, err)
+	}
+	if err := imp.db.Get(&staging, fmt.Sprintf("SELECT COUNT(*) FROM %s%s", spec.Name, stagingSuffix)); err != nil {
+		return 0, fmt.Errorf("erro ao contar as linhas de '%s%s': %w", spec.Name, stagingSuffix, err)
+	}
+
+	if staging == 0 {
+		return 0, fmt.Errorf("a carga de '%s' não produziu nenhuma linha", spec.Name)
+	}
+	if live == 0 {
+		return staging, nil
+	}
+
+	variation := math.Abs(float64(staging-live)) / float64(live)
+	if variation > imp.opts.Tolerance {
+		return 0, fmt.Errorf("a carga de '%s' tem %d linhas contra %d da geração atual (variação de %.1f%%, tolerância de %.1f%%)",
+			spec.Name, staging, live, variation*100, imp.opts.Tolerance*100)
+	}
+	log.Printf("Tabela '%s': %d linhas (geração atual: %d, variação de %.2f%%)", spec.Name, staging, live, variation*100)
+	return staging, nil
+}
+
+// swapTables coloca as tabelas _staging no ar em uma única transação, junto com o log de mudanças
+// e o registro no catálogo de versões, e descarta os checkpoints da carga.
+// A geração viva passa a ser _old (a _old anterior é descartada) e as consultas em andamento
+// continuam vendo os dados antigos até o COMMIT.
+func (imp *Importer) swapTables(specs []TableSpec, version *dataVersion) error {
+	return imp.inSwapTx(func(tx *sqlx.Tx) error {
+		if err := recordChanges(tx, specs, version.reference); err != nil {
+			return err
+		}
+		if err := recordVersion(tx, specs, version); err != nil {
+			return err
+		}
+		if err := clearCheckpoints(tx, specs); err != nil {
+			return err
+		}
+		for _, spec := range specs {
+			for _, table := range spec.generations() {
+				if _, err := tx.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s%s", table, previousSuffix)); err != nil {
+					return fmt.Errorf("erro ao descartar a geração anterior de '%s': %w", table, err)
+				}
+				if err := renameGeneration(tx, table, liveSuffix, previousSuffix); err != nil {
+					return err
+				}
+				if err := renameGeneration(tx, table, stagingSuffix, liveSuffix); err != nil {
+					return err
+				}
+				if err := ownSequences(tx, table); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Rollback devolve ao ar a geração anterior (_old) das tabelas informadas (ou de todas),
+// e a geração que estava no ar passa a ser a _old. Rodar Rollback duas vezes desfaz o rollback.
+// As mudanças registradas pela carga desfeita são apagadas e não voltam com um segundo Rollback;
+// para tê-las de novo, importe o dump outra vez.
+func (imp *Importer) Rollback(names []string) error {
+	specs, err := selectTables(names)
+	if err != nil {
+		return err
+	}
+
+	return imp.inSwapTx(func(tx *sqlx.Tx) error {
+		for _, spec := range specs {
+			for _, table := range spec.generations() {
+				var exists bool
+				if err := tx.Get(&exists, "SELECT to_regclass($1) IS NOT NULL", table+previousSuffix); err != nil {
+					return fmt.Errorf("erro ao verificar a geração anterior de '%s': %w", table, err)
+				}
+				if !exists {
+					return fmt.Errorf("não há geração anterior de '%s' para restaurar", table)
+				}
+
+				if _, err := tx.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s%s", table, stagingSuffix)); err != nil {
+					return fmt.Errorf("erro ao remover '%s%s': %w", table, stagingSuffix, err)
+				}
+				if err := renameGeneration(tx, table, liveSuffix, stagingSuffix); err != nil {
+					return err
+				}
+				if err := renameGeneration(tx, table, previousSuffix, liveSuffix); err != nil {
+					return err
+				}
+				if err := renameGeneration(tx, table, stagingSuffix, previousSuffix); err != nil {
+					return err
+				}
+				if err := ownSequences(tx, table); err != nil {
+					return err
+				}
+			}
+			ref, ok, err := liveReference(tx, spec.Name)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := clearChanges(tx, spec.Name, ref); err != nil {
+					return err
+				}
+			}
+			if err := swapVersionGenerations(tx, spec.Name); err != nil {
+				return err
+			}
+			log.Printf("Tabela '%s' restaurada para a geração anterior.", spec.Name)
+		}
+		return nil
+	})
+}
+
+// inSwapTx executa fn em uma transação com lock_timeout, para que a troca falhe em vez de bloquear o servidor.
+func (imp *Importer) inSwapTx(fn func(tx *sqlx.Tx) error) error {
+	tx, err := imp.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar a transação de troca: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(fmt.Sprintf("SET LOCAL lock_timeout = '%s'", swapLockTimeout)); err != nil {
+		return fmt.Errorf("erro ao configurar lock_timeout: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("erro ao confirmar a troca de tabelas: %w", err)
+	}
+	return nil
+}
+
+// renameGeneration renomeia <tabela><from> para <tabela><to>, junto com seus índices.
+func renameGeneration(tx *sqlx.Tx, table, from, to string) error {
+	indexes, err := listIndexes(tx, table+from)
+	if err != nil {
+		return err
+	}
+	for _, idx := range indexes {
+		base := strings.TrimSuffix(idx.Name, from)
+		if _, err := tx.Exec(fmt.Sprintf("ALTER INDEX %s RENAME TO %s", idx.Name, base+to)); err != nil {
+			return fmt.Errorf("erro ao renomear o índice '%s': %w", idx.Name, err)
+		}
+	}
+	if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table+from, table+to)); err != nil {
+		return fmt.Errorf("erro ao renomear a tabela '%s': %w", table+from, err)
+	}
+	return nil
+}
+
+// ownSequences transfere para a tabela viva a posse das sequences usadas nos defaults (ex.: estabelecimento.id).
+// Todas as gerações compartilham a mesma sequence, copiada pelo LIKE ... INCLUDING DEFAULTS; sem isso,
+// descartar a geração _old tentaria apagar a sequence ainda usada pela tabela viva.
+func ownSequences(tx *sqlx.Tx, table string) error {
+	var sequences []struct {
+		Column   string `db:"column_name"`
+		Sequence string `db:"sequence_name"`
+	}
+	query := `
+		SELECT a.attname AS column_name, s.oid::regclass::text AS sequence_name
+		FROM pg_attribute a
+		JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+		JOIN pg_depend dep ON dep.classid = 'pg_attrdef'::regclass AND dep.objid = d.oid
+		JOIN pg_class s ON s.oid = dep.refobjid AND s.relkind = 'S'
+		WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped
+	`
+	if err := tx.Select(&sequences, query, table); err != nil {
+		return fmt.Errorf("erro ao listar as sequences de '%s': %w", table, err)
+	}
+	for _, s := range sequences {
+		if _, err := tx.Exec(fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s.%s", s.Sequence, table, s.Column)); err != nil {
+			return fmt.Errorf("erro ao transferir a sequence '%s': %w", s.Sequence, err)
+		}
+	}
+	return nil
+}
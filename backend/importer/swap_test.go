@@ -0,0 +1,98 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// loadCNAE simula uma carga de cnae: um código com a descrição informada vai para cnae_staging,
+// que é indexada e colocada no ar com o mês de referência ref.
+func loadCNAE(t *testing.T, imp *Importer, ref, descricao string) {
+	t.Helper()
+	spec, _ := FindTable("cnae")
+	if err := imp.prepareStaging(spec.Name); err != nil {
+		t.Fatal(err)
+	}
+	mustExec(t, imp.db, "INSERT INTO cnae_staging (codigo, descricao) VALUES ('6201501', $1)", descricao)
+	if err := imp.buildStagingIndexes(spec.Name); err != nil {
+		t.Fatal(err)
+	}
+	version := &dataVersion{reference: ref, started: time.Now(), rows: map[string]int64{spec.Name: 1}}
+	if err := imp.swapTables([]TableSpec{spec}, version); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// cnaeDescricao devolve a descrição do código carregado por loadCNAE na tabela informada.
+func cnaeDescricao(t *testing.T, db *sqlx.DB, table string) string {
+	t.Helper()
+	var descricao string
+	if err := db.Get(&descricao, "SELECT descricao FROM "+table+" WHERE codigo = '6201501'"); err != nil {
+		t.Fatalf("erro ao consultar %s: %v", table, err)
+	}
+	return descricao
+}
+
+// liveCNAEReference devolve o mês de referência da versão viva de cnae no catálogo.
+func liveCNAEReference(t *testing.T, db *sqlx.DB) string {
+	t.Helper()
+	tx, err := db.Beginx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	ref, ok, err := liveReference(tx, "cnae")
+	if err != nil || !ok {
+		t.Fatalf("liveReference(cnae) = %q, %v, %v", ref, ok, err)
+	}
+	return ref
+}
+
+func TestSwapTablesAndRollback(t *testing.T) {
+	db := testDB(t)
+	imp := NewImporter(db, Options{Tolerance: 1})
+
+	loadCNAE(t, imp, "2025-05", "maio")
+	loadCNAE(t, imp, "2025-06", "junho")
+	if got := cnaeDescricao(t, db, "cnae"); got != "junho" {
+		t.Errorf("geração viva depois da troca = %q, esperado junho", got)
+	}
+	if got := cnaeDescricao(t, db, "cnae"+previousSuffix); got != "maio" {
+		t.Errorf("geração anterior depois da troca = %q, esperado maio", got)
+	}
+	if n := count(t, db, "SELECT count(*) FROM pg_indexes WHERE schemaname = current_schema() AND indexname = 'cnae_pkey'"); n != 1 {
+		t.Errorf("a chave primária da carga nova não ficou com o nome da tabela viva (%d índices cnae_pkey)", n)
+	}
+	if got := liveCNAEReference(t, db); got != "2025-06" {
+		t.Errorf("versão viva no catálogo = %q, esperado 2025-06", got)
+	}
+
+	if err := imp.Rollback([]string{"cnae"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := cnaeDescricao(t, db, "cnae"); got != "maio" {
+		t.Errorf("geração viva depois do rollback = %q, esperado maio", got)
+	}
+	if got := liveCNAEReference(t, db); got != "2025-05" {
+		t.Errorf("versão viva no catálogo depois do rollback = %q, esperado 2025-05", got)
+	}
+
+	// Um segundo rollback desfaz o primeiro.
+	if err := imp.Rollback([]string{"cnae"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := cnaeDescricao(t, db, "cnae"); got != "junho" {
+		t.Errorf("geração viva depois do segundo rollback = %q, esperado junho", got)
+	}
+}
+
+func TestRollbackWithoutPreviousGeneration(t *testing.T) {
+	db := testDB(t)
+	imp := NewImporter(db, Options{})
+
+	if err := imp.Rollback([]string{"cnae"}); err == nil {
+		t.Error("Rollback sem geração anterior deveria falhar")
+	}
+}
@@ -0,0 +1,147 @@
+// neurocloser/backend/importer/tables.go
+package importer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TableSpec descreve como um arquivo dos Dados Abertos CNPJ é carregado em uma tabela do banco.
+type TableSpec struct {
+	Name      string   // Tabela de destino (a mesma consultada pelos repositories)
+	ZipPrefix string   // Prefixo dos arquivos .zip da Receita (ex.: "Empresas" para Empresas0.zip ... Empresas9.zip)
+	Fields    int      // Quantidade de campos de cada linha do CSV
+	Columns   []string // Colunas de destino, na mesma ordem dos valores retornados por Convert
+	// Convert transforma os campos de uma linha do CSV nos valores das colunas de destino.
+	Convert func(fields []string) ([]interface{}, error)
+	// Derived são as tabelas preenchidas pelo importador a partir desta depois da carga
+	// (ex.: estabelecimento_cnae_secundaria). Passam pelas mesmas gerações _staging e _old e entram
+	// no ar, ou voltam no rollback, junto com ela.
+	Derived []string
+}
+
+// generations retorna a tabela e as suas derivadas, que trocam de geração juntas.
+func (s TableSpec) generations() []string {
+	return append([]string{s.Name}, s.Derived...)
+}
+
+// Tables lista as tabelas suportadas na ordem em que devem ser carregadas.
+var Tables = []TableSpec{
+	referenceTable("cnae", "Cnaes"),
+	referenceTable("natureza_juridica", "Naturezas"),
+	referenceTable("qualificacao", "Qualificacoes"),
+	referenceTable("pais", "Paises"),
+	referenceTable("municipio", "Municipios"),
+	referenceTable("motivo", "Motivos"),
+	{
+		Name:      "empresas",
+		ZipPrefix: "Empresas",
+		Fields:    7,
+		Columns: []string{
+			"cnpj_basico", "razao_social", "natureza_juridica", "qualificacao_responsavel",
+			"capital_social", "porte_empresa", "ente_federativo_responsavel",
+		},
+		Convert: convertEmpresa,
+	},
+	{
+		Name:      "estabelecimento",
+		ZipPrefix: "Estabelecimentos",
+		Fields:    30,
+		Columns: []string{
+			"cnpj", "cnpj_basico", "cnpj_ordem", "cnpj_dv", "matriz_filial", "nome_fantasia",
+			"situacao_cadastral", "data_situacao_cadastral", "motivo_situacao_cadastral",
+			"nome_cidade_exterior", "pais", "data_inicio_atividades", "cnae_fiscal",
+			"cnae_fiscal_secundaria", "tipo_logradouro", "logradouro", "numero", "complemento",
+			"bairro", "cep", "uf", "municipio", "ddd1", "telefone1", "ddd2", "telefone2",
+			"ddd_fax", "fax", "correio_eletronico", "situacao_especial", "data_situacao_especial",
+		},
+		Convert: convertEstabelecimento,
+		Derived: []string{"estabelecimento_cnae_secundaria"},
+	},
+	{
+		Name:      "socios",
+		ZipPrefix: "Socios",
+		Fields:    11,
+		Columns: []string{
+			"cnpj", "cnpj_basico", "identificador_de_socio", "nome_socio", "cnpj_cpf_socio",
+			"qualificacao_socio", "data_entrada_sociedade", "pais", "representante_legal",
+			"nome_representante", "qualificacao_representante_legal", "faixa_etaria",
+		},
+		Convert: convertSocio,
+	},
+	{
+		Name:      "simples",
+		ZipPrefix: "Simples",
+		Fields:    7,
+		Columns: []string{
+			"cnpj_basico", "opcao_simples", "data_opcao_simples", "data_exclusao_simples",
+			"opcao_mei", "data_opcao_mei", "data_exclusao_mei",
+		},
+		Convert: convertStrings,
+	},
+}
+
+// referenceTable descreve um arquivo de domínio da Receita (*.CNAECSV, *.NATJUCSV, *.QUALSCSV,
+// *.PAISCSV, *.MUNICCSV, *.MOTICSV), todos com as colunas codigo;descricao.
+func referenceTable(name, zipPrefix string) TableSpec {
+	return TableSpec{
+		Name:      name,
+		ZipPrefix: zipPrefix,
+		Fields:    2,
+		Columns:   []string{"codigo", "descricao"},
+		Convert:   convertStrings,
+	}
+}
+
+// FindTable retorna a especificação da tabela pelo nome.
+func FindTable(name string) (TableSpec, bool) {
+	for _, t := range Tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return TableSpec{}, false
+}
+
+// convertStrings copia os campos sem nenhuma transformação.
+func convertStrings(fields []string) ([]interface{}, error) {
+	values := make([]interface{}, len(fields))
+	for i, f := range fields {
+		values[i] = cleanField(f)
+	}
+	return values, nil
+}
+
+// convertEmpresa converte uma linha de *.EMPRECSV. O capital social vem com vírgula decimal ("1000,00").
+func convertEmpresa(fields []string) ([]interface{}, error) {
+	values, _ := convertStrings(fields)
+
+	capital := strings.ReplaceAll(cleanField(fields[4]), ",", ".")
+	if capital == "" {
+		values[4] = 0.0
+	} else {
+		v, err := strconv.ParseFloat(capital, 64)
+		if err != nil {
+			return nil, fmt.Errorf("capital social inválido '%s': %w", fields[4], err)
+		}
+		values[4] = v
+	}
+	return values, nil
+}
+
+// convertEstabelecimento converte uma linha de *.ESTABELE, montando o CNPJ completo
+// a partir de cnpj_basico + cnpj_ordem + cnpj_dv.
+func convertEstabelecimento(fields []string) ([]interface{}, error) {
+	values, _ := convertStrings(fields)
+
+	cnpj := values[0].(string) + values[1].(string) + values[2].(string)
+	return append([]interface{}{cnpj}, values...), nil
+}
+
+// convertSocio converte uma linha de *.SOCIOCSV. O arquivo não traz o CNPJ completo;
+// a coluna cnpj é preenchida depois com o CNPJ da matriz (ver fillSociosCNPJ).
+func convertSocio(fields []string) ([]interface{}, error) {
+	values, _ := convertStrings(fields)
+	return append([]interface{}{""}, values...), nil
+}
@@ -0,0 +1,142 @@
+// neurocloser/backend/importer/validation.go
+package importer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Validator verifica uma linha bruta do CSV antes da conversão.
+// Pode corrigir os campos no próprio slice (retornando fixed=true) ou rejeitar a linha com um erro,
+// que vira o motivo registrado na quarentena.
+type Validator interface {
+	Validate(fields []string) (fixed bool, err error)
+}
+
+// ValidatorFunc permite usar uma função comum como Validator.
+type ValidatorFunc func(fields []string) (bool, error)
+
+// Validate chama a própria função.
+func (f ValidatorFunc) Validate(fields []string) (bool, error) {
+	return f(fields)
+}
+
+var (
+	validatorsMu sync.RWMutex
+	validators   = map[string][]Validator{}
+)
+
+// RegisterValidator acrescenta validadores às linhas de uma tabela. Eles rodam na ordem de registro,
+// sem que o núcleo do importador precise conhecê-los.
+func RegisterValidator(table string, vs ...Validator) {
+	validatorsMu.Lock()
+	defer validatorsMu.Unlock()
+	validators[table] = append(validators[table], vs...)
+}
+
+// validateRow aplica os validadores registrados para a tabela. Para no primeiro erro.
+func validateRow(table string, fields []string) (bool, error) {
+	validatorsMu.RLock()
+	vs := validators[table]
+	validatorsMu.RUnlock()
+
+	fixed := false
+	for _, v := range vs {
+		f, err := v.Validate(fields)
+		if err != nil {
+			return fixed, err
+		}
+		fixed = fixed || f
+	}
+	return fixed, nil
+}
+
+// quarentenaColumns são as colunas preenchidas em cada linha rejeitada (tabela criada pelas migrations).
+var quarentenaColumns = []string{"tabela", "referencia", "arquivo", "linha", "motivo", "conteudo"}
+
+// rejectedRow é uma linha que vai para a quarentena.
+type rejectedRow struct {
+	file   string
+	line   int
+	reason string
+	raw    string
+}
+
+func (r rejectedRow) values(table, ref string) []interface{} {
+	return []interface{}{table, ref, r.file, r.line, r.reason, r.raw}
+}
+
+// TableReport resume o resultado da importação de uma tabela.
+type TableReport struct {
+	Table    string
+	Accepted int64         // Linhas carregadas sem alteração
+	Fixed    int64         // Linhas carregadas depois de corrigidas por algum validador
+	Rejected int64         // Linhas enviadas para a quarentena
+	Elapsed  time.Duration // Tempo de carga nesta execução (sem índices e troca)
+
+	mu        sync.Mutex // Os workers de importTable atualizam o resumo em paralelo
+	processed int64      // Linhas lidas nesta execução, sem as de checkpoints anteriores
+}
+
+// add soma contagens ao resumo.
+func (t *TableReport) add(accepted, fixed, rejected int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Accepted += accepted
+	t.Fixed += fixed
+	t.Rejected += rejected
+}
+
+// addProcessed soma as linhas lidas nesta execução, usadas no cálculo da vazão.
+func (t *TableReport) addProcessed(rows int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.processed += rows
+}
+
+// Throughput é a vazão da carga nesta execução, em linhas por segundo.
+func (t *TableReport) Throughput() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return rate(t.processed, t.Elapsed)
+}
+
+// Report é o resumo de uma execução, por tabela.
+type Report []*TableReport
+
+// String formata o resumo como uma tabela de texto.
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-16s %12s %12s %12s %10s %10s\n", "tabela", "aceitas", "corrigidas", "rejeitadas", "tempo", "linhas/s")
+	for _, t := range r {
+		fmt.Fprintf(&b, "%-16s %12d %12d %12d %10v %10.0f\n",
+			t.Table, t.Accepted, t.Fixed, t.Rejected, t.Elapsed.Round(time.Second), t.Throughput())
+	}
+	return b.String()
+}
+
+// tableReport retorna (criando, se preciso) o resumo da tabela.
+func (r *Report) tableReport(table string) *TableReport {
+	for _, t := range *r {
+		if t.Table == table {
+			return t
+		}
+	}
+	t := &TableReport{Table: table}
+	*r = append(*r, t)
+	sort.SliceStable(*r, func(i, j int) bool { return tableOrder((*r)[i].Table) < tableOrder((*r)[j].Table) })
+	return t
+}
+
+// tableOrder é a posição da tabela em Tables, para o relatório seguir a ordem de carga.
+func tableOrder(name string) int {
+	for i, t := range Tables {
+		if t.Name == name {
+			return i
+		}
+	}
+	return len(Tables)
+}
@@ -0,0 +1,174 @@
+// neurocloser/backend/importer/validators.go
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+)
+
+// Validadores padrão dos arquivos da Receita. Novas regras podem ser acrescentadas com RegisterValidator.
+func init() {
+	RegisterValidator("empresas",
+		CNPJPartValidator{Column: "cnpj_basico", Field: 0, Length: 8},
+		DecimalValidator{Column: "capital_social", Field: 4},
+	)
+	RegisterValidator("estabelecimento",
+		CNPJPartValidator{Column: "cnpj_basico", Field: 0, Length: 8},
+		CNPJPartValidator{Column: "cnpj_ordem", Field: 1, Length: 4},
+		DigitsValidator{Column: "cnpj_dv", Field: 2, Length: 2},
+		CNPJValidator{Basico: 0, Ordem: 1, DV: 2},
+		DateValidator{Column: "data_situacao_cadastral", Field: 6},
+		DateValidator{Column: "data_inicio_atividades", Field: 10},
+		DateValidator{Column: "data_situacao_especial", Field: 29},
+	)
+	RegisterValidator("socios",
+		CNPJPartValidator{Column: "cnpj_basico", Field: 0, Length: 8},
+		DateValidator{Column: "data_entrada_sociedade", Field: 5},
+	)
+	RegisterValidator("simples",
+		CNPJPartValidator{Column: "cnpj_basico", Field: 0, Length: 8},
+		DateValidator{Column: "data_opcao_simples", Field: 2},
+		DateValidator{Column: "data_exclusao_simples", Field: 3},
+		DateValidator{Column: "data_opcao_mei", Field: 5},
+		DateValidator{Column: "data_exclusao_mei", Field: 6},
+	)
+}
+
+// DigitsValidator exige um campo numérico de tamanho fixo (ex.: dígitos verificadores do CNPJ).
+// Valores curtos perderam os zeros à esquerda em alguma exportação e são completados.
+type DigitsValidator struct {
+	Column string
+	Field  int
+	Length int
+}
+
+// Validate completa zeros à esquerda ou rejeita o campo.
+func (v DigitsValidator) Validate(fields []string) (bool, error) {
+	value := cleanField(fields[v.Field])
+	if value == "" || strings.Trim(value, "0123456789") != "" {
+		return false, fmt.Errorf("%s não numérico: '%s'", v.Column, value)
+	}
+	if len(value) > v.Length {
+		return false, fmt.Errorf("%s com %d dígitos, esperado %d: '%s'", v.Column, len(value), v.Length, value)
+	}
+	if len(value) < v.Length {
+		fields[v.Field] = strings.Repeat("0", v.Length-len(value)) + value
+		return true, nil
+	}
+	return false, nil
+}
+
+// CNPJPartValidator exige a raiz (cnpj_basico) ou a ordem (cnpj_ordem) do CNPJ com tamanho fixo.
+// Desde julho de 2026 essas partes podem ter letras (CNPJ alfanumérico); letras minúsculas passam para
+// maiúsculas e só valores numéricos curtos são completados com zeros à esquerda.
+type CNPJPartValidator struct {
+	Column string
+	Field  int
+	Length int
+}
+
+// Validate normaliza o campo ou rejeita a linha.
+func (v CNPJPartValidator) Validate(fields []string) (bool, error) {
+	original := cleanField(fields[v.Field])
+	value := strings.ToUpper(original)
+	if value == "" || strings.Trim(value, "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return false, fmt.Errorf("%s com caracteres inválidos: '%s'", v.Column, original)
+	}
+	if len(value) > v.Length {
+		return false, fmt.Errorf("%s com %d caracteres, esperado %d: '%s'", v.Column, len(value), v.Length, original)
+	}
+	if len(value) < v.Length {
+		if strings.Trim(value, "0123456789") != "" {
+			return false, fmt.Errorf("%s alfanumérico com %d caracteres, esperado %d: '%s'", v.Column, len(value), v.Length, original)
+		}
+		value = strings.Repeat("0", v.Length-len(value)) + value
+	}
+	fields[v.Field] = value
+	return value != original, nil
+}
+
+// CNPJValidator confere os dígitos verificadores do CNPJ montado a partir de raiz, ordem e DV,
+// numérico ou alfanumérico. Deve rodar depois dos validadores que normalizam essas partes.
+type CNPJValidator struct {
+	Basico, Ordem, DV int
+}
+
+// Validate rejeita a linha se os dígitos verificadores não conferem.
+func (v CNPJValidator) Validate(fields []string) (bool, error) {
+	cnpj := fields[v.Basico] + fields[v.Ordem] + fields[v.DV]
+	if !models.CheckCNPJ(cnpj) {
+		return false, fmt.Errorf("dígitos verificadores do CNPJ não conferem: '%s'", cnpj)
+	}
+	return false, nil
+}
+
+// DateValidator aceita datas YYYYMMDD válidas ou vazias. Os marcadores de data ausente
+// usados pela Receita ("0" e "00000000") viram vazio; qualquer outra data impossível rejeita a linha.
+type DateValidator struct {
+	Column string
+	Field  int
+}
+
+// Validate limpa datas ausentes ou rejeita datas impossíveis.
+func (v DateValidator) Validate(fields []string) (bool, error) {
+	value := cleanField(fields[v.Field])
+	switch value {
+	case "":
+		return false, nil
+	case "0", "00000000":
+		// Os marcadores são o formato normal do arquivo para data ausente; não contam como correção.
+		fields[v.Field] = ""
+		return false, nil
+	}
+	if _, err := time.Parse("20060102", value); err != nil {
+		return false, fmt.Errorf("%s inválida: '%s'", v.Column, value)
+	}
+	return false, nil
+}
+
+// Formatos aceitos por DecimalValidator: "1500,00" (o dos arquivos da Receita) ou "1500.00", e com
+// separador de milhar, "1.500,00".
+var (
+	decimalPattern          = regexp.MustCompile(`^(\d+)(?:[,.]\d+)?$`)
+	thousandsDecimalPattern = regexp.MustCompile(`^\d{1,3}(?:\.\d{3})+,\d+$`)
+)
+
+// maxDecimalDigits é a quantidade de dígitos da parte inteira que cabe em NUMERIC(18,2).
+const maxDecimalDigits = 16
+
+// DecimalValidator normaliza valores com vírgula decimal ("1.234,56" vira "1234.56")
+// e rejeita o que não estiver nesse formato (inclusive NaN, Inf e notação científica)
+// ou não couber em NUMERIC(18,2).
+type DecimalValidator struct {
+	Column string
+	Field  int
+}
+
+// Validate converte o campo para o formato com ponto decimal.
+func (v DecimalValidator) Validate(fields []string) (bool, error) {
+	original := cleanField(fields[v.Field])
+	if original == "" {
+		return false, nil
+	}
+
+	value := original
+	// A vírgula decimal é o formato normal do arquivo; só conta como correção o separador de milhar.
+	fixed := thousandsDecimalPattern.MatchString(value)
+	if fixed {
+		value = strings.ReplaceAll(value, ".", "")
+	}
+	m := decimalPattern.FindStringSubmatch(value)
+	if m == nil {
+		return false, fmt.Errorf("%s não numérico: '%s'", v.Column, original)
+	}
+	if len(strings.TrimLeft(m[1], "0")) > maxDecimalDigits {
+		return false, fmt.Errorf("%s fora do intervalo: '%s'", v.Column, original)
+	}
+
+	fields[v.Field] = strings.Replace(value, ",", ".", 1)
+	return fixed, nil
+}
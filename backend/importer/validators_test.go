@@ -0,0 +1,129 @@
+package importer
+
+import "testing"
+
+func TestCNPJPartValidator(t *testing.T) {
+	v := CNPJPartValidator{Column: "cnpj_basico", Field: 0, Length: 8}
+	tests := []struct {
+		in      string
+		want    string
+		fixed   bool
+		wantErr bool
+	}{
+		{"12ABC345", "12ABC345", false, false},
+		{"12abc345", "12ABC345", true, false},
+		{"33000167", "33000167", false, false},
+		{"191", "00000191", true, false},
+		{"12ABC", "", false, true}, // Raiz alfanumérica curta não é completada
+		{"12ABC3456", "", false, true},
+		{"12-BC345", "", false, true},
+		{"", "", false, true},
+	}
+	for _, tt := range tests {
+		fields := []string{tt.in}
+		fixed, err := v.Validate(fields)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%q): erro = %v, esperado erro: %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (fields[0] != tt.want || fixed != tt.fixed) {
+			t.Errorf("Validate(%q) = %q (corrigido: %v), esperado %q (corrigido: %v)", tt.in, fields[0], fixed, tt.want, tt.fixed)
+		}
+	}
+}
+
+func TestCNPJValidator(t *testing.T) {
+	v := CNPJValidator{Basico: 0, Ordem: 1, DV: 2}
+	valid := [][]string{
+		{"12ABC345", "01DE", "35"}, // Exemplo da Receita para o CNPJ alfanumérico
+		{"33000167", "0001", "01"},
+	}
+	for _, fields := range valid {
+		if _, err := v.Validate(fields); err != nil {
+			t.Errorf("Validate(%v) retornou erro: %v", fields, err)
+		}
+	}
+	invalid := [][]string{
+		{"12ABC345", "01DE", "36"},
+		{"33000167", "0001", "02"},
+	}
+	for _, fields := range invalid {
+		if _, err := v.Validate(fields); err == nil {
+			t.Errorf("Validate(%v) deveria rejeitar a linha", fields)
+		}
+	}
+}
+
+func TestDateValidator(t *testing.T) {
+	v := DateValidator{Column: "data_inicio_atividades", Field: 0}
+	tests := []struct {
+		in      string
+		want    string
+		fixed   bool
+		wantErr bool
+	}{
+		{"20240131", "20240131", false, false},
+		{"20240229", "20240229", false, false},
+		{"", "", false, false},
+		{"0", "", false, false},
+		{"00000000", "", false, false},
+		{"20240230", "", false, true},
+		{"20231301", "", false, true},
+		{"2024-01-31", "", false, true},
+		{"abc", "", false, true},
+	}
+	for _, tt := range tests {
+		fields := []string{tt.in}
+		fixed, err := v.Validate(fields)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%q): erro = %v, esperado erro: %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (fields[0] != tt.want || fixed != tt.fixed) {
+			t.Errorf("Validate(%q) = %q (corrigido: %v), esperado %q (corrigido: %v)", tt.in, fields[0], fixed, tt.want, tt.fixed)
+		}
+	}
+}
+
+func TestDecimalValidator(t *testing.T) {
+	v := DecimalValidator{Column: "capital_social", Field: 0}
+	tests := []struct {
+		in      string
+		want    string
+		fixed   bool
+		wantErr bool
+	}{
+		{"1500,00", "1500.00", false, false}, // Formato do capital_social nos arquivos da Receita
+		{"0,00", "0.00", false, false},
+		{"1.234,56", "1234.56", true, false},
+		{"1.000.000,00", "1000000.00", true, false},
+		{"1500.50", "1500.50", false, false},
+		{"1500", "1500", false, false},
+		{"", "", false, false},
+		{"0001500,00", "0001500.00", false, false},
+		{"9999999999999999,99", "9999999999999999.99", false, false}, // Maior valor de NUMERIC(18,2)
+		{"1,2,3", "", false, true},
+		{"abc", "", false, true},
+		{"NaN", "", false, true},
+		{"Inf", "", false, true},
+		{"-Infinity", "", false, true},
+		{"1e400", "", false, true},
+		{"1,5e3", "", false, true},
+		{"-10,00", "", false, true},
+		{"1.234", "1.234", false, false},
+		{"1.234.567", "", false, true},
+		{"12.34,56", "", false, true},
+		{"10000000000000000,00", "", false, true}, // 17 dígitos na parte inteira
+	}
+	for _, tt := range tests {
+		fields := []string{tt.in}
+		fixed, err := v.Validate(fields)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%q): erro = %v, esperado erro: %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (fields[0] != tt.want || fixed != tt.fixed) {
+			t.Errorf("Validate(%q) = %q (corrigido: %v), esperado %q (corrigido: %v)", tt.in, fields[0], fixed, tt.want, tt.fixed)
+		}
+	}
+}
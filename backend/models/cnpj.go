@@ -0,0 +1,143 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CNPJ é o scalar GraphQL de CNPJ completo, numérico ou alfanumérico. Aceita o valor formatado
+// ("12.345.678/0001-95", "12.ABC.345/01DE-35") ou sem formatação, valida os dígitos verificadores e guarda
+// os 14 caracteres sem formatação e em maiúsculas, como na coluna estabelecimento.cnpj.
+type CNPJ string
+
+// UnmarshalGQL valida e normaliza o CNPJ recebido. Entradas inválidas retornam *ValidationError.
+func (c *CNPJ) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return &ValidationError{Field: "cnpj", Value: fmt.Sprint(v), Reason: "o CNPJ deve ser uma string"}
+	}
+	cnpj, err := NormalizeCNPJ(s)
+	if err != nil {
+		return err
+	}
+	*c = CNPJ(cnpj)
+	return nil
+}
+
+// MarshalGQL escreve o CNPJ sem formatação.
+func (c CNPJ) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(string(c)))
+}
+
+// NormalizeCNPJ remove a formatação (pontos, barra, hífen e espaços), passa as letras para maiúsculas
+// e valida os dígitos verificadores. Aceita o CNPJ numérico e o alfanumérico (a partir de julho de 2026),
+// em que as 12 primeiras posições podem ter letras e só os 2 dígitos verificadores são sempre numéricos.
+// Retorna os 14 caracteres ou um *ValidationError.
+func NormalizeCNPJ(s string) (string, error) {
+	cnpj := CleanCNPJ(s)
+
+	if len(cnpj) != 14 {
+		return "", &ValidationError{Field: "cnpj", Value: s, Reason: "o CNPJ deve ter 14 caracteres"}
+	}
+	if !isCNPJBase(cnpj[:12]) || !isDigits(cnpj[12:]) {
+		return "", &ValidationError{Field: "cnpj", Value: s, Reason: "o CNPJ só pode ter letras e dígitos, e os verificadores são numéricos"}
+	}
+	// Sequências repetidas (00000000000000, 11111111111111...) passam no cálculo, mas não são CNPJs.
+	if strings.Count(cnpj, cnpj[:1]) == len(cnpj) {
+		return "", &ValidationError{Field: "cnpj", Value: s, Reason: "CNPJ inválido"}
+	}
+	if !CheckCNPJ(cnpj) {
+		return "", &ValidationError{Field: "cnpj", Value: s, Reason: "dígitos verificadores do CNPJ não conferem"}
+	}
+	return cnpj, nil
+}
+
+// CleanCNPJ remove a formatação (pontos, barra, hífen e espaços) de um CNPJ completo ou básico e passa as
+// letras para maiúsculas, sem validar o resultado.
+func CleanCNPJ(s string) string {
+	return strings.ToUpper(strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '/', '-', ' ':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s)))
+}
+
+// NormalizeCNPJBasico valida o CNPJ básico (raiz de 8 caracteres, numérica ou alfanumérica) e o devolve
+// em maiúsculas. Raízes numéricas curtas, que perderam os zeros à esquerda, são completadas.
+func NormalizeCNPJBasico(s string) (string, error) {
+	basico := strings.ToUpper(strings.TrimSpace(s))
+	if basico != "" && len(basico) < 8 && isDigits(basico) {
+		basico = strings.Repeat("0", 8-len(basico)) + basico
+	}
+	if len(basico) != 8 || !isCNPJBase(basico) {
+		return "", &ValidationError{Field: "cnpjBasico", Value: s, Reason: "o CNPJ básico deve ter 8 letras ou dígitos"}
+	}
+	return basico, nil
+}
+
+// CheckCNPJ indica se os dígitos verificadores de um CNPJ já normalizado (14 caracteres) conferem.
+func CheckCNPJ(cnpj string) bool {
+	return len(cnpj) == 14 && cnpj[12] == cnpjCheckDigit(cnpj[:12]) && cnpj[13] == cnpjCheckDigit(cnpj[:13])
+}
+
+// FormatCNPJ formata o CNPJ no padrão "XX.XXX.XXX/XXXX-XX", que vale também para o alfanumérico
+// ("12.ABC.345/01DE-35"). Valores que não têm 14 caracteres são devolvidos como vieram.
+func FormatCNPJ(cnpj string) string {
+	if len(cnpj) != 14 {
+		return cnpj
+	}
+	return fmt.Sprintf("%s.%s.%s/%s-%s", cnpj[0:2], cnpj[2:5], cnpj[5:8], cnpj[8:12], cnpj[12:14])
+}
+
+// isCNPJBase indica se s só tem dígitos e letras maiúsculas, os caracteres aceitos na raiz e na ordem do CNPJ.
+func isCNPJBase(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !(s[i] >= '0' && s[i] <= '9') && !(s[i] >= 'A' && s[i] <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigits indica se s só tem dígitos.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// cnpjCheckDigit calcula o dígito verificador (módulo 11) dos 12 ou 13 primeiros caracteres do CNPJ.
+// Os pesos vão de 2 a 9, da direita para a esquerda, recomeçando em 2 depois do 9. Cada caractere vale
+// seu código ASCII menos 48: os dígitos valem 0 a 9 e as letras, de 'A' = 17 a 'Z' = 42.
+func cnpjCheckDigit(base string) byte {
+	sum, weight := 0, 2
+	for i := len(base) - 1; i >= 0; i-- {
+		sum += int(base[i]-'0') * weight
+		if weight++; weight > 9 {
+			weight = 2
+		}
+	}
+	if rest := sum % 11; rest >= 2 {
+		return byte('0' + 11 - rest)
+	}
+	return '0'
+}
+
+// ValidationError é o erro de entrada inválida (CNPJ, datas, limites...). O servidor GraphQL o devolve
+// com extensions.code = "VALIDACAO" e o campo em extensions.campo (ver graphql.ErrorPresenter).
+type ValidationError struct {
+	Field  string // Campo ou argumento inválido
+	Value  string // Valor recebido
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: '%s'", e.Reason, e.Value)
+}
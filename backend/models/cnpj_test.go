@@ -0,0 +1,96 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+// Exemplos da Receita Federal para o CNPJ alfanumérico e CNPJs numéricos reais.
+func TestNormalizeCNPJ(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"12.ABC.345/01DE-35", "12ABC34501DE35"},
+		{"12ABC34501DE35", "12ABC34501DE35"},
+		{"12.abc.345/01de-35", "12ABC34501DE35"},
+		{"33.000.167/0001-01", "33000167000101"},
+		{"00.000.000/0001-91", "00000000000191"},
+		{" 11222333000181 ", "11222333000181"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeCNPJ(tt.in)
+		if err != nil {
+			t.Errorf("NormalizeCNPJ(%q) retornou erro: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeCNPJ(%q) = %q, esperado %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeCNPJInvalido(t *testing.T) {
+	for _, in := range []string{
+		"12.ABC.345/01DE-36", // Dígito verificador errado
+		"12.ABC.345/01DE-3A", // Verificador com letra
+		"12.AB*.345/01DE-35", // Caractere fora de [0-9A-Z]
+		"11.222.333/0001-82",
+		"00000000000000",
+		"AAAAAAAAAAAAAA",
+		"1222333000181",
+		"",
+	} {
+		_, err := NormalizeCNPJ(in)
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("NormalizeCNPJ(%q) = %v, esperado *ValidationError", in, err)
+		}
+	}
+}
+
+func TestCNPJCheckDigit(t *testing.T) {
+	// Exemplo da Receita: a base 12ABC34501DE tem os verificadores 3 e 5.
+	if got := cnpjCheckDigit("12ABC34501DE"); got != '3' {
+		t.Errorf("primeiro dígito verificador = %c, esperado 3", got)
+	}
+	if got := cnpjCheckDigit("12ABC34501DE3"); got != '5' {
+		t.Errorf("segundo dígito verificador = %c, esperado 5", got)
+	}
+}
+
+func TestNormalizeCNPJBasico(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"12ABC345", "12ABC345", false},
+		{"12abc345", "12ABC345", false},
+		{"33000167", "33000167", false},
+		{"191", "00000191", false},
+		{"12ABC", "", true},
+		{"12ABC3456", "", true},
+		{"12-BC345", "", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeCNPJBasico(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("NormalizeCNPJBasico(%q) = %q, %v; esperado %q (erro: %v)", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestFormatCNPJ(t *testing.T) {
+	tests := map[string]string{
+		"12ABC34501DE35": "12.ABC.345/01DE-35",
+		"33000167000101": "33.000.167/0001-01",
+		"123":            "123",
+	}
+	for in, want := range tests {
+		if got := FormatCNPJ(in); got != want {
+			t.Errorf("FormatCNPJ(%q) = %q, esperado %q", in, got, want)
+		}
+	}
+}
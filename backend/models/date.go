@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layouts de data: o dos arquivos da Receita e o ISO 8601 usado no GraphQL.
+const (
+	receitaDateLayout = "20060102"
+	isoDateLayout     = "2006-01-02"
+)
+
+// Date é uma data sem hora das tabelas da Receita (início de atividades, situação cadastral, opção pelo
+// Simples...). Também é o scalar GraphQL Date, no formato ISO (YYYY-MM-DD). Datas ausentes, inclusive os
+// marcadores "0" e "00000000" da Receita, ficam com Valid = false e saem como null.
+type Date struct {
+	Time  time.Time
+	Valid bool
+}
+
+// ParseReceitaDate converte uma data como gravada no banco: YYYYMMDD (formato da Receita) ou YYYY-MM-DD.
+// Vazio, "0" e "00000000" resultam em uma data nula.
+func ParseReceitaDate(s string) (Date, error) {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "", "0", "00000000":
+		return Date{}, nil
+	}
+	layout := receitaDateLayout
+	if strings.Contains(s, "-") {
+		layout = isoDateLayout
+	}
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("data inválida '%s': %w", s, err)
+	}
+	return Date{Time: t, Valid: true}, nil
+}
+
+// Scan implementa sql.Scanner para as colunas de data (TEXT no formato da Receita ou DATE).
+// As colunas TEXT guardam o que veio no arquivo; valores que não são uma data válida (ex.: "20240230",
+// "99999999") são lidos como nulos, para que uma linha ruim não derrube a consulta inteira.
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = Date{Time: v, Valid: true}
+		return nil
+	case []byte:
+		return d.scanString(string(v))
+	case string:
+		return d.scanString(v)
+	}
+	return fmt.Errorf("tipo de data não suportado: %T", src)
+}
+
+func (d *Date) scanString(s string) error {
+	parsed, err := ParseReceitaDate(s)
+	if err != nil {
+		parsed = Date{}
+	}
+	*d = parsed
+	return nil
+}
+
+// ReceitaString devolve a data no formato das colunas da Receita (YYYYMMDD), usado nos filtros SQL.
+func (d Date) ReceitaString() string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Time.Format(receitaDateLayout)
+}
+
+// String devolve a data no formato ISO (YYYY-MM-DD), ou vazio se nula.
+func (d Date) String() string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Time.Format(isoDateLayout)
+}
+
+// UnmarshalGQL aceita datas ISO (YYYY-MM-DD). Entradas inválidas retornam *ValidationError.
+func (d *Date) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return &ValidationError{Field: "data", Value: fmt.Sprint(v), Reason: "a data deve ser uma string no formato YYYY-MM-DD"}
+	}
+	t, err := time.Parse(isoDateLayout, strings.TrimSpace(s))
+	if err != nil {
+		return &ValidationError{Field: "data", Value: s, Reason: "data inválida, esperado YYYY-MM-DD"}
+	}
+	*d = Date{Time: t, Valid: true}
+	return nil
+}
+
+// MarshalGQL escreve a data no formato ISO, ou null se ausente.
+func (d Date) MarshalGQL(w io.Writer) {
+	if !d.Valid {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.Quote(d.String()))
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseReceitaDate(t *testing.T) {
+	tests := []struct {
+		in        string
+		want      string // ISO; vazio para data nula
+		wantError bool
+	}{
+		{"20240131", "2024-01-31", false},
+		{"2024-01-31", "2024-01-31", false},
+		{" 19991231 ", "1999-12-31", false},
+		{"20240229", "2024-02-29", false}, // Ano bissexto
+		{"", "", false},
+		{"0", "", false},
+		{"00000000", "", false},
+		{"20240230", "", true}, // 30 de fevereiro
+		{"20231301", "", true}, // Mês 13
+		{"2023-02-29", "", true},
+		{"2024-1-31", "", true},
+		{"31/01/2024", "", true},
+		{"abc", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseReceitaDate(tt.in)
+		if (err != nil) != tt.wantError {
+			t.Errorf("ParseReceitaDate(%q): erro = %v, esperado erro: %v", tt.in, err, tt.wantError)
+			continue
+		}
+		if err == nil && (got.String() != tt.want || got.Valid != (tt.want != "")) {
+			t.Errorf("ParseReceitaDate(%q) = %q (válida: %v), esperado %q", tt.in, got.String(), got.Valid, tt.want)
+		}
+	}
+}
+
+func TestDateScan(t *testing.T) {
+	dia := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		src  interface{}
+		want string
+	}{
+		{"coluna DATE", dia, "2024-01-31"},
+		{"texto da Receita", "20240131", "2024-01-31"},
+		{"bytes da Receita", []byte("20240131"), "2024-01-31"},
+		{"texto ISO", "2024-01-31", "2024-01-31"},
+		{"NULL", nil, ""},
+		{"marcador 0", "0", ""},
+		{"marcador 00000000", []byte("00000000"), ""},
+		{"vazio", "", ""},
+		{"data impossível", "20240230", ""},
+		{"marcador 99999999", []byte("99999999"), ""},
+		{"texto qualquer", "abc", ""},
+	}
+	for _, tt := range tests {
+		d := Date{Time: time.Now(), Valid: true} // Scan precisa sobrescrever o valor anterior
+		if err := d.Scan(tt.src); err != nil {
+			t.Errorf("%s: erro inesperado: %v", tt.name, err)
+			continue
+		}
+		if d.String() != tt.want || d.Valid != (tt.want != "") {
+			t.Errorf("%s: Scan = %q (válida: %v), esperado %q", tt.name, d.String(), d.Valid, tt.want)
+		}
+	}
+
+	var d Date
+	if err := d.Scan(20240131); err == nil {
+		t.Error("Scan aceitou um tipo não suportado")
+	}
+}
+
+func TestDateReceitaString(t *testing.T) {
+	d, _ := ParseReceitaDate("2024-01-31")
+	if got := d.ReceitaString(); got != "20240131" {
+		t.Errorf("ReceitaString() = %q, esperado 20240131", got)
+	}
+	if got := (Date{}).ReceitaString(); got != "" {
+		t.Errorf("ReceitaString() de data nula = %q, esperado vazio", got)
+	}
+}
+
+func TestDateGQL(t *testing.T) {
+	var buf bytes.Buffer
+	d, _ := ParseReceitaDate("20240131")
+	d.MarshalGQL(&buf)
+	if buf.String() != `"2024-01-31"` {
+		t.Errorf("MarshalGQL = %s, esperado \"2024-01-31\"", buf.String())
+	}
+	buf.Reset()
+	Date{}.MarshalGQL(&buf)
+	if buf.String() != "null" {
+		t.Errorf("MarshalGQL de data nula = %s, esperado null", buf.String())
+	}
+
+	var in Date
+	if err := in.UnmarshalGQL("2024-01-31"); err != nil || in.ReceitaString() != "20240131" {
+		t.Errorf("UnmarshalGQL(2024-01-31) = %v (%v)", in, err)
+	}
+	for _, v := range []interface{}{"20240131", "2024-02-30", "", 20240131} {
+		var verr *ValidationError
+		if err := in.UnmarshalGQL(v); !errors.As(err, &verr) {
+			t.Errorf("UnmarshalGQL(%v) = %v, esperado *ValidationError", v, err)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package models
+
+// Mudanca representa a tabela 'mudancas': uma diferença detectada entre duas cargas dos Dados Abertos CNPJ.
+type Mudanca struct {
+	ID            int     `json:"id" db:"id"`
+	Tipo          string  `json:"tipo" db:"tipo"` // CNPJ_ABERTO, CNPJ_BAIXADO, SOCIO_ENTRADA, ...
+	CNPJ          string  `json:"cnpj" db:"cnpj"`
+	CNPJBasico    string  `json:"cnpj_basico" db:"cnpj_basico"`
+	UF            *string `json:"uf" db:"uf"`
+	CNAEFiscal    *string `json:"cnae_fiscal" db:"cnae_fiscal"`
+	ValorAnterior *string `json:"valor_anterior" db:"valor_anterior"`
+	ValorNovo     *string `json:"valor_novo" db:"valor_novo"`
+	DetectadaEm   Date    `json:"detectada_em" db:"detectada_em"` // Data da carga que detectou a mudança
+	Referencia    *string `json:"referencia" db:"referencia"`     // Mês de referência (AAAA-MM) dessa carga
+}
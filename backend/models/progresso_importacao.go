@@ -0,0 +1,15 @@
+package models
+
+// ProgressoImportacao é um evento de progresso do importador (cmd/importer), publicado via NOTIFY
+// no PostgreSQL e repassado pelo servidor às assinaturas GraphQL.
+type ProgressoImportacao struct {
+	Referencia        string  `json:"referencia"`         // Mês de referência do dump (AAAA-MM)
+	Fase              string  `json:"fase"`               // PARSE, LOAD, INDEX, SWAP, DONE ou FAILED
+	Tabela            *string `json:"tabela"`             // Tabela em carga (nil em SWAP, DONE e FAILED)
+	Arquivo           *string `json:"arquivo"`            // Arquivo em leitura, <zip>/<csv> (só em PARSE e LOAD)
+	LinhasProcessadas int     `json:"linhas_processadas"` // Linhas da tabela já carregadas ou rejeitadas
+	Rejeitadas        int     `json:"rejeitadas"`         // Linhas da tabela enviadas para a quarentena
+	EtaSegundos       *int    `json:"eta_segundos"`       // Estimativa para terminar a carga da tabela
+	Mensagem          *string `json:"mensagem"`           // Detalhe da fase (índice em criação, erro)
+	Em                string  `json:"em"`                 // Momento do evento (ISO 8601, UTC)
+}
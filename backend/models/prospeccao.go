@@ -4,6 +4,30 @@ type ProspeccaoDetalhada struct {
 	Empresa         *Empresa         `json:"empresa"`
 	Estabelecimento *Estabelecimento `json:"estabelecimento"`
 	Socios          []*Socio         `json:"socios"`
+	Simples         *Simples         `json:"simples"`        // Opção pelo Simples/MEI (nil se nunca optou)
 	CNAEFiscal      *CNAE            `json:"cnaeFiscal"`     // CNAE Fiscal Principal
 	CNAESecundaria  []*CNAE          `json:"cnaeSecundaria"` // CNAEs Secundários
+	Score           *float64         `json:"score"`          // Relevância na busca textual ou similaridade do nome (nil nas demais buscas)
+}
+
+// ProspeccaoConnection é uma página de buscarProspeccaoConnection. O totalCount é resolvido à parte,
+// com os filtros guardados aqui, para que a contagem só rode quando o campo for pedido.
+type ProspeccaoConnection struct {
+	Edges    []*ProspeccaoEdge      `json:"edges"`
+	PageInfo *PageInfo              `json:"pageInfo"`
+	Filtros  map[string]interface{} `json:"-"`
+}
+
+// ProspeccaoEdge liga um resultado da prospecção ao cursor que aponta para ele.
+type ProspeccaoEdge struct {
+	Cursor string               `json:"cursor"`
+	Node   *ProspeccaoDetalhada `json:"node"`
+}
+
+// PageInfo segue a especificação de conexões do Relay.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
 }
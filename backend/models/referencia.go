@@ -0,0 +1,8 @@
+package models
+
+// Referencia representa as tabelas de domínio da Receita (natureza_juridica, qualificacao, pais,
+// municipio e motivo), que traduzem os códigos usados em empresas, estabelecimento e socios.
+type Referencia struct {
+	Codigo    string `json:"codigo" db:"codigo"`
+	Descricao string `json:"descricao" db:"descricao"`
+}
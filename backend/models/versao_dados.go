@@ -0,0 +1,29 @@
+package models
+
+// VersaoDados descreve os dados no ar: a carga mais recente dos Dados Abertos CNPJ (tabela 'versoes_dados')
+// e, para cada tabela, de qual carga vieram as linhas consultadas hoje.
+type VersaoDados struct {
+	ID          int              `json:"id" db:"id"`
+	Referencia  string           `json:"referencia" db:"referencia"`     // Mês de referência do dump (AAAA-MM)
+	IniciadaEm  string           `json:"iniciada_em" db:"iniciada_em"`   // ISO 8601, UTC
+	ConcluidaEm string           `json:"concluida_em" db:"concluida_em"` // ISO 8601, UTC
+	Tabelas     []*VersaoTabela  `json:"tabelas" db:"-"`
+	Arquivos    []*VersaoArquivo `json:"arquivos" db:"-"`
+}
+
+// VersaoTabela é uma tabela no ar e a carga de onde ela veio.
+type VersaoTabela struct {
+	Tabela      string `json:"tabela" db:"tabela"`
+	Referencia  string `json:"referencia" db:"referencia"`
+	Linhas      int    `json:"linhas" db:"linhas"`
+	Rejeitadas  int    `json:"rejeitadas" db:"rejeitadas"` // Linhas enviadas para a quarentena
+	CarregadaEm string `json:"carregada_em" db:"carregada_em"`
+}
+
+// VersaoArquivo é um arquivo de origem de uma tabela no ar.
+type VersaoArquivo struct {
+	Tabela   string `json:"tabela" db:"tabela"`
+	Arquivo  string `json:"arquivo" db:"arquivo"`   // <zip>/<csv>
+	Checksum string `json:"checksum" db:"checksum"` // SHA-256 do zip
+	Linhas   int    `json:"linhas" db:"linhas"`
+}
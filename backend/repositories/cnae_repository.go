@@ -7,12 +7,15 @@ import (
 	"github.com/edufilhocruz/neurocloser/backend/models"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 // CNAERepository define a interface para operações de dados de CNAE.
 type CNAERepository interface {
 	GetCNAEByCodigo(codigo string) (*models.CNAE, error)
 	GetCNAEsByCodigos(codigos []string) ([]*models.CNAE, error)
+	// Para o Dataloader de CNAEs secundários: os CNAEs de cada estabelecimento, agrupados por CNPJ.
+	GetCNAEsSecundariosByCNPJs(cnpjs []string) (map[string][]*models.CNAE, error)
 }
 
 // cnaeRepository implementa CNAERepository para PostgreSQL.
@@ -61,3 +64,32 @@ func (r *cnaeRepository) GetCNAEsByCodigos(codigos []string) ([]*models.CNAE, er
 	}
 	return cnaes, nil
 }
+
+// GetCNAEsSecundariosByCNPJs busca os CNAEs secundários de vários estabelecimentos em estabelecimento_cnae_secundaria,
+// na ordem em que a Receita os lista. Códigos sem descrição na tabela cnae ficam de fora.
+func (r *cnaeRepository) GetCNAEsSecundariosByCNPJs(cnpjs []string) (map[string][]*models.CNAE, error) {
+	if len(cnpjs) == 0 {
+		return map[string][]*models.CNAE{}, nil
+	}
+
+	var rows []struct {
+		CNPJ string `db:"cnpj"`
+		models.CNAE
+	}
+	query := `
+		SELECT ecs.cnpj, c.codigo, c.descricao
+		FROM estabelecimento_cnae_secundaria ecs
+		JOIN cnae c ON c.codigo = ecs.cnae
+		WHERE ecs.cnpj = ANY($1)
+		ORDER BY ecs.cnpj, ecs.ordem
+	`
+	if err := r.db.Select(&rows, query, pq.Array(cnpjs)); err != nil {
+		return nil, fmt.Errorf("erro ao buscar CNAEs secundários por CNPJs: %w", err)
+	}
+
+	cnaesMap := make(map[string][]*models.CNAE)
+	for i := range rows {
+		cnaesMap[rows[i].CNPJ] = append(cnaesMap[rows[i].CNPJ], &rows[i].CNAE)
+	}
+	return cnaesMap, nil
+}
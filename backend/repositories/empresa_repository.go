@@ -11,12 +11,24 @@ import (
 
 // EmpresaRepository define a interface para operações de dados da Empresa.
 type EmpresaRepository interface {
-	GetAllEmpresas(limit *int) ([]*models.Empresa, error)
+	GetAllEmpresas(limit *int, offset *int) ([]*models.Empresa, error)
 	GetEmpresaByCNPJBasico(cnpjBasico string) (*models.Empresa, error)
 	// NOVO MÉTODO PARA DATALOADER:
 	GetEmpresasByCNPJBasicos(cnpjBasicos []string) ([]*models.Empresa, error)
 }
 
+// empresaColumns são as colunas de empresas para models.Empresa. Só cnpj_basico é NOT NULL; as demais
+// passam por COALESCE porque os campos do model não são ponteiros. Capital social ausente vira 0, como
+// na prospecção.
+const empresaColumns = `
+			cnpj_basico,
+			COALESCE(razao_social, '') AS razao_social,
+			COALESCE(natureza_juridica, '') AS natureza_juridica,
+			COALESCE(qualificacao_responsavel, '') AS qualificacao_responsavel,
+			COALESCE(porte_empresa, '') AS porte_empresa,
+			COALESCE(ente_federativo_responsavel, '') AS ente_federativo_responsavel,
+			COALESCE(capital_social, 0) AS capital_social`
+
 // empresaRepository implementa EmpresaRepository para PostgreSQL.
 type empresaRepository struct {
 	db *sqlx.DB
@@ -27,15 +39,22 @@ func NewEmpresaRepository(db *sqlx.DB) EmpresaRepository {
 	return &empresaRepository{db: db}
 }
 
-// GetAllEmpresas busca todas as empresas do banco de dados, com um limite opcional.
-func (r *empresaRepository) GetAllEmpresas(limit *int) ([]*models.Empresa, error) {
+// GetAllEmpresas busca todas as empresas do banco de dados, com limite e offset opcionais.
+// A ordem por CNPJ básico mantém as páginas estáveis entre chamadas.
+func (r *empresaRepository) GetAllEmpresas(limit *int, offset *int) ([]*models.Empresa, error) {
 	empresas := []*models.Empresa{}
-	query := `SELECT cnpj_basico, razao_social, natureza_juridica, qualificacao_responsavel, porte_empresa, ente_federativo_responsavel, capital_social FROM empresas`
+	query := `SELECT ` + empresaColumns + ` FROM empresas ORDER BY cnpj_basico`
+	args := []interface{}{}
 	if limit != nil && *limit > 0 {
-		query += fmt.Sprintf(" LIMIT %d", *limit)
+		args = append(args, *limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if offset != nil && *offset > 0 {
+		args = append(args, *offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
 	}
 
-	err := r.db.Select(&empresas, query)
+	err := r.db.Select(&empresas, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao consultar empresas: %w", err)
 	}
@@ -46,9 +65,7 @@ func (r *empresaRepository) GetAllEmpresas(limit *int) ([]*models.Empresa, error
 func (r *empresaRepository) GetEmpresaByCNPJBasico(cnpjBasico string) (*models.Empresa, error) {
 	var empresa models.Empresa
 	query := `
-		SELECT
-			cnpj_basico, razao_social, natureza_juridica, qualificacao_responsavel,
-			porte_empresa, ente_federativo_responsavel, capital_social
+		SELECT ` + empresaColumns + `
 		FROM empresas WHERE cnpj_basico = $1`
 
 	err := r.db.Get(&empresa, query, cnpjBasico)
@@ -68,7 +85,7 @@ func (r *empresaRepository) GetEmpresasByCNPJBasicos(cnpjBasicos []string) ([]*m
 	}
 
 	var empresas []*models.Empresa
-	query := `SELECT cnpj_basico, razao_social, natureza_juridica, qualificacao_responsavel, porte_empresa, ente_federativo_responsavel, capital_social FROM empresas WHERE cnpj_basico IN (?)`
+	query := `SELECT ` + empresaColumns + ` FROM empresas WHERE cnpj_basico IN (?)`
 	query, args, err := sqlx.In(query, cnpjBasicos)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao criar query IN para empresas: %w", err)
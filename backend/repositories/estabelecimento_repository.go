@@ -2,13 +2,15 @@
 package repositories
 
 import (
-	"backend/models"
 	"database/sql"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 // EstabelecimentoComEmpresa é uma struct auxiliar que combina campos de Estabelecimento e Empresa.
@@ -22,14 +24,27 @@ type EstabelecimentoComEmpresa struct {
 	EmpresaPorteEmpresa              sql.NullString `db:"emp_porte_empresa"`
 	EmpresaEnteFederativoResponsavel sql.NullString `db:"emp_ente_federativo_responsavel"`
 	EmpresaCapitalSocialStr          sql.NullString `db:"emp_capital_social"`
+	// Relevância (ts_rank) quando a busca usa texto
+	Score sql.NullFloat64 `db:"score"`
 }
 
 // EstabelecimentoRepository define a interface para operações de dados do Estabelecimento.
 type EstabelecimentoRepository interface {
 	GetEstabelecimentoByID(id int) (*models.Estabelecimento, error)
 	GetEstabelecimentoByCNPJBasico(cnpjBasico string) (*models.Estabelecimento, error)
+	GetEstabelecimentoByCNPJ(cnpj string) (*models.Estabelecimento, error)
+	// Para os Dataloaders: todos os estabelecimentos (matriz e filiais) e só as matrizes de cada CNPJ básico.
+	GetEstabelecimentosByCNPJBasicos(cnpjBasicos []string) (map[string][]*models.Estabelecimento, error)
+	GetMatrizesByCNPJBasicos(cnpjBasicos []string) ([]*models.Estabelecimento, error)
+	// Para prospeccaoPorCnpjs: vários estabelecimentos pelo CNPJ completo.
+	GetEstabelecimentosByCNPJs(cnpjs []string) ([]*models.Estabelecimento, error)
 	// Retorna uma slice do novo tipo combinado EstabelecimentoComEmpresa
 	FindEstabelecimentosByFilters(filters map[string]interface{}, limit *int, offset *int) ([]*EstabelecimentoComEmpresa, error)
+	// Paginação por cursor (keyset sobre e.cnpj) e contagem para buscarProspeccaoConnection.
+	FindEstabelecimentosByFiltersAfter(filters map[string]interface{}, after *ProspeccaoCursor, limit int) ([]*EstabelecimentoComEmpresa, error)
+	CountEstabelecimentosByFilters(filters map[string]interface{}) (int, error)
+	// Para empresasSimilares: estabelecimentos com razão social ou nome fantasia parecidos com nome.
+	FindEmpresasSimilares(nome, uf string, limiar float64, limit int) ([]*EstabelecimentoComEmpresa, error)
 }
 
 // estabelecimentoRepository implementa EstabelecimentoRepository para PostgreSQL.
@@ -42,228 +57,165 @@ func NewEstabelecimentoRepository(db *sqlx.DB) EstabelecimentoRepository {
 	return &estabelecimentoRepository{db: db}
 }
 
-// GetEstabelecimentoByID busca um estabelecimento pelo seu ID.
-func (r *estabelecimentoRepository) GetEstabelecimentoByID(id int) (*models.Estabelecimento, error) {
-	var (
-		estabelecimento         models.Estabelecimento
-		idStr                   sql.NullString
-		cnpj                    sql.NullString
-		cnpjBasico              sql.NullString
-		cnpjOrdem               sql.NullString
-		cnpjDv                  sql.NullString
-		matrizFilial            sql.NullString
-		nomeFantasia            sql.NullString
-		situacaoCadastral       sql.NullString
-		dataSituacaoCadastral   sql.NullString
-		motivoSituacaoCadastral sql.NullString
-		nomeCidadeExterior      sql.NullString
-		pais                    sql.NullString
-		dataInicioAtividades    sql.NullString
-		cnaeFiscal              sql.NullString
-		cnaeFiscalSecundaria    sql.NullString
-		tipoLogradouro          sql.NullString
-		logradouro              sql.NullString
-		numero                  sql.NullString
-		complemento             sql.NullString
-		bairro                  sql.NullString
-		cep                     sql.NullString
-		uf                      sql.NullString
-		municipio               sql.NullString
-		ddd1                    sql.NullString
-		telefone1               sql.NullString
-		ddd2                    sql.NullString
-		telefone2               sql.NullString
-		dddFax                  sql.NullString
-		fax                     sql.NullString
-		correioEletronico       sql.NullString
-		situacaoEspecial        sql.NullString
-		dataSituacaoEspecial    sql.NullString
-	)
+// estabelecimentoColumnList são as colunas de estabelecimento, na ordem da tabela, e como cada uma é lida.
+// A Receita deixa em branco os campos não informados e o importador os grava vazios; as colunas
+// opcionais passam por NULLIF para chegarem como null ao GraphQL. As obrigatórias passam por COALESCE,
+// porque os campos correspondentes do model são string, e as datas são tratadas por models.Date.
+var estabelecimentoColumnList = []struct {
+	name string
+	kind columnKind
+}{
+	{"cnpj", requiredColumn}, {"cnpj_basico", requiredColumn}, {"cnpj_ordem", requiredColumn},
+	{"cnpj_dv", requiredColumn}, {"matriz_filial", requiredColumn}, {"nome_fantasia", nullableColumn},
+	{"situacao_cadastral", requiredColumn}, {"data_situacao_cadastral", dateColumn},
+	{"motivo_situacao_cadastral", nullableColumn}, {"nome_cidade_exterior", nullableColumn},
+	{"pais", nullableColumn}, {"data_inicio_atividades", dateColumn}, {"cnae_fiscal", requiredColumn},
+	{"cnae_fiscal_secundaria", nullableColumn}, {"tipo_logradouro", requiredColumn},
+	{"logradouro", requiredColumn}, {"numero", requiredColumn}, {"complemento", nullableColumn},
+	{"bairro", nullableColumn}, {"cep", requiredColumn}, {"uf", requiredColumn}, {"municipio", requiredColumn},
+	{"ddd1", nullableColumn}, {"telefone1", nullableColumn}, {"ddd2", nullableColumn},
+	{"telefone2", nullableColumn}, {"ddd_fax", nullableColumn}, {"fax", nullableColumn},
+	{"correio_eletronico", nullableColumn}, {"situacao_especial", nullableColumn},
+	{"data_situacao_especial", dateColumn},
+}
 
-	query := `
-        SELECT
-            id, cnpj, cnpj_basico, cnpj_ordem, cnpj_dv, matriz_filial, nome_fantasia,
-            situacao_cadastral, data_situacao_cadastral, motivo_situacao_cadastral,
-            nome_cidade_exterior, pais, data_inicio_atividades, cnae_fiscal,
-            cnae_fiscal_secundaria, tipo_logradouro, logradouro, numero, complemento,
-            bairro, cep, uf, municipio, ddd1, telefone1, ddd2, telefone2,
-            ddd_fax, fax, correio_eletronico, situacao_especial, data_situacao_especial
-        FROM estabelecimento
-        WHERE id = $1
-    `
-	row := r.db.QueryRow(query, id)
-
-	err := row.Scan(
-		&idStr, &cnpj, &cnpjBasico, &cnpjOrdem, &cnpjDv, &matrizFilial, &nomeFantasia,
-		&situacaoCadastral, &dataSituacaoCadastral, &motivoSituacaoCadastral,
-		&nomeCidadeExterior, &pais, &dataInicioAtividades, &cnaeFiscal,
-		&cnaeFiscalSecundaria, &tipoLogradouro, &logradouro, &numero, &complemento,
-		&bairro, &cep, &uf, &municipio, &ddd1, &telefone1, &ddd2, &telefone2,
-		&dddFax, &fax, &correioEletronico, &situacaoEspecial, &dataSituacaoEspecial,
-	)
+// columnKind diz como uma coluna de texto da Receita é lida para o model.
+type columnKind int
+
+const (
+	requiredColumn columnKind = iota // COALESCE(col, ''), para campos string
+	nullableColumn                   // NULLIF(col, ''), para campos *string
+	dateColumn                       // Sem transformação; models.Date trata vazio, "0" e "00000000"
+)
+
+// selectColumn monta a expressão do SELECT de uma coluna, com o alias da tabela (ex.: "e.") ou vazio.
+func selectColumn(alias, name string, kind columnKind) string {
+	switch kind {
+	case requiredColumn:
+		return fmt.Sprintf("COALESCE(%s%s, '') AS %s", alias, name, name)
+	case nullableColumn:
+		return fmt.Sprintf("NULLIF(%s%s, '') AS %s", alias, name, name)
+	}
+	return alias + name
+}
 
+// estabelecimentoColumns monta a lista de colunas de estabelecimento para sqlx.Select/Get em models.Estabelecimento.
+func estabelecimentoColumns(alias string) string {
+	parts := []string{alias + "id"}
+	for _, c := range estabelecimentoColumnList {
+		parts = append(parts, selectColumn(alias, c.name, c.kind))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// getEstabelecimento busca um único estabelecimento. Retorna nil, nil se nenhum for encontrado.
+func (r *estabelecimentoRepository) getEstabelecimento(where string, args ...interface{}) (*models.Estabelecimento, error) {
+	var estabelecimento models.Estabelecimento
+	query := "SELECT " + estabelecimentoColumns("") + " FROM estabelecimento " + where
+	err := r.db.Get(&estabelecimento, query, args...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("erro ao buscar estabelecimento por ID: %w", err)
+		return nil, err
 	}
-
-	if idStr.Valid {
-		parsedID, _ := strconv.Atoi(idStr.String)
-		estabelecimento.ID = parsedID
-	} else {
-		estabelecimento.ID = 0
-	}
-
-	estabelecimento.CNPJ = cnpj.String
-	estabelecimento.CNPJBasico = cnpjBasico.String
-	estabelecimento.CNPJOrdem = cnpjOrdem.String
-	estabelecimento.CNPJDV = cnpjDv.String
-	estabelecimento.MatrizFilial = matrizFilial.String
-	estabelecimento.NomeFantasia = nomeFantasia.String
-	estabelecimento.SituacaoCadastral = situacaoCadastral.String
-	estabelecimento.DataSituacaoCadastral = dataSituacaoCadastral.String
-	estabelecimento.MotivoSituacaoCadastral = motivoSituacaoCadastral.String
-	estabelecimento.NomeCidadeExterior = nomeCidadeExterior.String
-	estabelecimento.Pais = pais.String
-	estabelecimento.DataInicioAtividades = dataInicioAtividades.String
-	estabelecimento.CNAEFiscal = cnaeFiscal.String
-	estabelecimento.CNAEFiscalSecundaria = cnaeFiscalSecundaria.String
-	estabelecimento.TipoLogradouro = tipoLogradouro.String
-	estabelecimento.Logradouro = logradouro.String
-	estabelecimento.Numero = numero.String
-	estabelecimento.Complemento = complemento.String
-	estabelecimento.Bairro = bairro.String
-	estabelecimento.CEP = cep.String
-	estabelecimento.UF = uf.String
-	estabelecimento.Municipio = municipio.String
-	estabelecimento.DDD1 = ddd1.String
-	estabelecimento.Telefone1 = telefone1.String
-	estabelecimento.DDD2 = ddd2.String
-	estabelecimento.Telefone2 = telefone2.String
-	estabelecimento.DDDFax = dddFax.String
-	estabelecimento.Fax = fax.String
-	estabelecimento.CorreioEletronico = correioEletronico.String
-	estabelecimento.SituacaoEspecial = situacaoEspecial.String
-	estabelecimento.DataSituacaoEspecial = dataSituacaoEspecial.String
-
 	estabelecimento.FormatCNPJ() // Chama a função de formatação
-
 	return &estabelecimento, nil
 }
 
+// GetEstabelecimentoByID busca um estabelecimento pelo seu ID.
+func (r *estabelecimentoRepository) GetEstabelecimentoByID(id int) (*models.Estabelecimento, error) {
+	estabelecimento, err := r.getEstabelecimento("WHERE id = $1", id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estabelecimento por ID: %w", err)
+	}
+	return estabelecimento, nil
+}
+
 // GetEstabelecimentoByCNPJBasico busca um estabelecimento pelo seu CNPJ Básico.
 func (r *estabelecimentoRepository) GetEstabelecimentoByCNPJBasico(cnpjBasico string) (*models.Estabelecimento, error) {
-	var (
-		estabelecimento         models.Estabelecimento
-		idStr                   sql.NullString
-		cnpj                    sql.NullString
-		cnpjBasicoCol           sql.NullString
-		cnpjOrdem               sql.NullString
-		cnpjDv                  sql.NullString
-		matrizFilial            sql.NullString
-		nomeFantasia            sql.NullString
-		situacaoCadastral       sql.NullString
-		dataSituacaoCadastral   sql.NullString
-		motivoSituacaoCadastral sql.NullString
-		nomeCidadeExterior      sql.NullString
-		pais                    sql.NullString
-		dataInicioAtividades    sql.NullString
-		cnaeFiscal              sql.NullString
-		cnaeFiscalSecundaria    sql.NullString
-		tipoLogradouro          sql.NullString
-		logradouro              sql.NullString
-		numero                  sql.NullString
-		complemento             sql.NullString
-		bairro                  sql.NullString
-		cep                     sql.NullString
-		uf                      sql.NullString
-		municipio               sql.NullString
-		ddd1                    sql.NullString
-		telefone1               sql.NullString
-		ddd2                    sql.NullString
-		telefone2               sql.NullString
-		dddFax                  sql.NullString
-		fax                     sql.NullString
-		correioEletronico       sql.NullString
-		situacaoEspecial        sql.NullString
-		dataSituacaoEspecial    sql.NullString
-	)
+	estabelecimento, err := r.getEstabelecimento("WHERE cnpj_basico = $1 ORDER BY matriz_filial DESC LIMIT 1", cnpjBasico)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estabelecimento por CNPJ Básico: %w", err)
+	}
+	return estabelecimento, nil
+}
 
-	query := `
-        SELECT
-            id, cnpj, cnpj_basico, cnpj_ordem, cnpj_dv, matriz_filial, nome_fantasia,
-            situacao_cadastral, data_situacao_cadastral, motivo_situacao_cadastral,
-            nome_cidade_exterior, pais, data_inicio_atividades, cnae_fiscal,
-            cnae_fiscal_secundaria, tipo_logradouro, logradouro, numero, complemento,
-            bairro, cep, uf, municipio, ddd1, telefone1, ddd2, telefone2,
-            ddd_fax, fax, correio_eletronico, situacao_especial, data_situacao_especial
-        FROM estabelecimento
-        WHERE cnpj_basico = $1
-        ORDER BY matriz_filial DESC
-        LIMIT 1
-    `
-	row := r.db.QueryRow(query, cnpjBasico)
-
-	err := row.Scan(
-		&idStr, &cnpj, &cnpjBasicoCol, &cnpjOrdem, &cnpjDv, &matrizFilial, &nomeFantasia,
-		&situacaoCadastral, &dataSituacaoCadastral, &motivoSituacaoCadastral,
-		&nomeCidadeExterior, &pais, &dataInicioAtividades, &cnaeFiscal,
-		&cnaeFiscalSecundaria, &tipoLogradouro, &logradouro, &numero, &complemento,
-		&bairro, &cep, &uf, &municipio, &ddd1, &telefone1, &ddd2, &telefone2,
-		&dddFax, &fax, &correioEletronico, &situacaoEspecial, &dataSituacaoEspecial,
-	)
+// GetEstabelecimentoByCNPJ busca um estabelecimento pelo CNPJ completo (14 caracteres, sem formatação).
+func (r *estabelecimentoRepository) GetEstabelecimentoByCNPJ(cnpj string) (*models.Estabelecimento, error) {
+	estabelecimento, err := r.getEstabelecimento("WHERE cnpj = $1", cnpj)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estabelecimento por CNPJ: %w", err)
+	}
+	return estabelecimento, nil
+}
+
+// GetEstabelecimentosByCNPJBasicos busca a matriz e as filiais de vários CNPJs básicos em uma única consulta,
+// agrupadas por CNPJ básico e ordenadas pelo CNPJ (a matriz, ordem 0001, costuma vir primeiro).
+func (r *estabelecimentoRepository) GetEstabelecimentosByCNPJBasicos(cnpjBasicos []string) (map[string][]*models.Estabelecimento, error) {
+	if len(cnpjBasicos) == 0 {
+		return map[string][]*models.Estabelecimento{}, nil
+	}
 
+	query := "SELECT " + estabelecimentoColumns("") + " FROM estabelecimento WHERE cnpj_basico IN (?) ORDER BY cnpj"
+	query, args, err := sqlx.In(query, cnpjBasicos)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("erro ao buscar estabelecimento por CNPJ Básico: %w", err)
+		return nil, fmt.Errorf("erro ao criar query IN para estabelecimentos: %w", err)
 	}
+	query = r.db.Rebind(query)
 
-	if idStr.Valid {
-		parsedID, _ := strconv.Atoi(idStr.String)
-		estabelecimento.ID = parsedID
-	} else {
-		estabelecimento.ID = 0
-	}
-
-	estabelecimento.CNPJ = cnpj.String
-	estabelecimento.CNPJBasico = cnpjBasicoCol.String
-	estabelecimento.CNPJOrdem = cnpjOrdem.String
-	estabelecimento.CNPJDV = cnpjDv.String
-	estabelecimento.MatrizFilial = matrizFilial.String
-	estabelecimento.NomeFantasia = nomeFantasia.String
-	estabelecimento.SituacaoCadastral = situacaoCadastral.String
-	estabelecimento.DataSituacaoCadastral = dataSituacaoCadastral.String
-	estabelecimento.MotivoSituacaoCadastral = motivoSituacaoCadastral.String
-	estabelecimento.NomeCidadeExterior = nomeCidadeExterior.String
-	estabelecimento.Pais = pais.String
-	estabelecimento.DataInicioAtividades = dataInicioAtividades.String
-	estabelecimento.CNAEFiscal = cnaeFiscal.String
-	estabelecimento.CNAEFiscalSecundaria = cnaeFiscalSecundaria.String
-	estabelecimento.TipoLogradouro = tipoLogradouro.String
-	estabelecimento.Logradouro = logradouro.String
-	estabelecimento.Numero = numero.String
-	estabelecimento.Complemento = complemento.String
-	estabelecimento.Bairro = bairro.String
-	estabelecimento.CEP = cep.String
-	estabelecimento.UF = uf.String
-	estabelecimento.Municipio = municipio.String
-	estabelecimento.DDD1 = ddd1.String
-	estabelecimento.Telefone1 = telefone1.String
-	estabelecimento.DDD2 = ddd2.String
-	estabelecimento.Telefone2 = telefone2.String
-	estabelecimento.DDDFax = dddFax.String
-	estabelecimento.Fax = fax.String
-	estabelecimento.CorreioEletronico = correioEletronico.String
-	estabelecimento.SituacaoEspecial = situacaoEspecial.String
-	estabelecimento.DataSituacaoEspecial = dataSituacaoEspecial.String
+	var estabelecimentos []*models.Estabelecimento
+	if err := r.db.Select(&estabelecimentos, query, args...); err != nil {
+		return nil, fmt.Errorf("erro ao buscar estabelecimentos por CNPJs básicos: %w", err)
+	}
 
-	estabelecimento.FormatCNPJ() // Chama a função de formatação
+	estabelecimentosMap := make(map[string][]*models.Estabelecimento)
+	for _, e := range estabelecimentos {
+		e.FormatCNPJ()
+		estabelecimentosMap[e.CNPJBasico] = append(estabelecimentosMap[e.CNPJBasico], e)
+	}
+	return estabelecimentosMap, nil
+}
 
-	return &estabelecimento, nil
+// GetMatrizesByCNPJBasicos busca o estabelecimento matriz (matriz_filial = '1') de vários CNPJs básicos.
+func (r *estabelecimentoRepository) GetMatrizesByCNPJBasicos(cnpjBasicos []string) ([]*models.Estabelecimento, error) {
+	if len(cnpjBasicos) == 0 {
+		return []*models.Estabelecimento{}, nil
+	}
+
+	query := "SELECT " + estabelecimentoColumns("") + " FROM estabelecimento WHERE cnpj_basico IN (?) AND matriz_filial = '1'"
+	query, args, err := sqlx.In(query, cnpjBasicos)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar query IN para matrizes: %w", err)
+	}
+	query = r.db.Rebind(query)
+
+	var matrizes []*models.Estabelecimento
+	if err := r.db.Select(&matrizes, query, args...); err != nil {
+		return nil, fmt.Errorf("erro ao buscar matrizes por CNPJs básicos: %w", err)
+	}
+	for _, e := range matrizes {
+		e.FormatCNPJ()
+	}
+	return matrizes, nil
+}
+
+// GetEstabelecimentosByCNPJs busca vários estabelecimentos pelo CNPJ completo em uma única consulta.
+// Usa = ANY($1) em vez de IN (?), porque os lotes de prospeccaoPorCnpjs podem passar do limite de parâmetros.
+func (r *estabelecimentoRepository) GetEstabelecimentosByCNPJs(cnpjs []string) ([]*models.Estabelecimento, error) {
+	if len(cnpjs) == 0 {
+		return []*models.Estabelecimento{}, nil
+	}
+
+	query := "SELECT " + estabelecimentoColumns("") + " FROM estabelecimento WHERE cnpj = ANY($1)"
+	var estabelecimentos []*models.Estabelecimento
+	if err := r.db.Select(&estabelecimentos, query, pq.Array(cnpjs)); err != nil {
+		return nil, fmt.Errorf("erro ao buscar estabelecimentos por CNPJs: %w", err)
+	}
+	for _, e := range estabelecimentos {
+		e.FormatCNPJ()
+	}
+	return estabelecimentos, nil
 }
 
 // struct auxiliar interna para escanear resultados de JOIN (Estabelecimento + Empresa)
@@ -278,134 +230,273 @@ type estabelecimentoWithEmpresa struct {
 	EmpresaPorteEmpresa              sql.NullString `db:"emp_porte_empresa"`
 	EmpresaEnteFederativoResponsavel sql.NullString `db:"emp_ente_federativo_responsavel"`
 	EmpresaCapitalSocialStr          sql.NullString `db:"emp_capital_social"`
+	// Relevância (ts_rank) quando a busca usa texto
+	Score sql.NullFloat64 `db:"score"`
 }
 
-// FindEstabelecimentosByFilters busca estabelecimentos com base em múltiplos critérios de filtro.
-// Retorna uma lista de EstabelecimentoComEmpresa, que inclui os dados de Empresa já carregados via JOIN.
-func (r *estabelecimentoRepository) FindEstabelecimentosByFilters(filters map[string]interface{}, limit *int, offset *int) ([]*EstabelecimentoComEmpresa, error) {
-	var results []estabelecimentoWithEmpresa // Vamos escanear para esta slice de structs combinadas
-
-	// Aprimorando o SELECT para usar aliases e selecionar todas as colunas de 'e' e 'emp'.
-	// Os aliases para colunas da empresa são CRUCIAIS para o sqlx mapear corretamente.
-	baseQuery := `
-		SELECT
-            e.id, e.cnpj, e.cnpj_basico, e.cnpj_ordem, e.cnpj_dv, e.matriz_filial, e.nome_fantasia,
-            e.situacao_cadastral, e.data_situacao_cadastral, e.motivo_situacao_cadastral,
-            e.nome_cidade_exterior, e.pais, e.data_inicio_atividades, e.cnae_fiscal,
-            e.cnae_fiscal_secundaria, e.tipo_logradouro, e.logradouro, e.numero, e.complemento,
-            e.bairro, e.cep, e.uf, e.municipio, e.ddd1, e.telefone1, e.ddd2, e.telefone2,
-            e.ddd_fax, e.fax, e.correio_eletronico, e.situacao_especial, e.data_situacao_especial,
+// prospeccaoSelect são as colunas lidas pela prospecção: as de 'e' e as de 'emp' com o prefixo emp_.
+// Os aliases para colunas da empresa são CRUCIAIS para o sqlx mapear corretamente.
+var prospeccaoSelect = estabelecimentoColumns("e.") + `,
             emp.razao_social AS emp_razao_social,
             emp.natureza_juridica AS emp_natureza_juridica,
             emp.qualificacao_responsavel AS emp_qualificacao_responsavel,
             emp.porte_empresa AS emp_porte_empresa,
             emp.ente_federativo_responsavel AS emp_ente_federativo_responsavel,
-            emp.capital_social AS emp_capital_social
-		FROM estabelecimento e
-		JOIN empresas emp ON e.cnpj_basico = emp.cnpj_basico
-		WHERE 1=1
-	`
-	queryParts := []string{baseQuery}
-	args := []interface{}{}
-	argCounter := 1
-
-	// Adicione os filtros
-	if cnpj, ok := filters["cnpj"].(string); ok && cnpj != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.cnpj = $%d", argCounter))
-		args = append(args, cnpj)
-		argCounter++
+            emp.capital_social AS emp_capital_social`
+
+// prospeccaoQuery é o FROM/WHERE de uma busca da prospecção, com os argumentos posicionais correspondentes.
+// Quem monta a consulta continua a numeração a partir de len(args)+1.
+type prospeccaoQuery struct {
+	from string
+	args []interface{}
+	rank string // Expressão do score quando há busca textual ou por nome parecido; vazia caso contrário
+	// limiar é o pg_trgm.similarity_threshold da busca por nome parecido (ver withLimiar); 0 sem ela
+	limiar float64
+}
+
+// columns são as colunas do SELECT, com o score quando houver.
+func (q *prospeccaoQuery) columns() string {
+	if q.rank == "" {
+		return prospeccaoSelect
 	}
-	if nomeFantasia, ok := filters["nomeFantasia"].(string); ok && nomeFantasia != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.nome_fantasia ILIKE $%d", argCounter))
-		args = append(args, "%"+nomeFantasia+"%")
-		argCounter++
+	return prospeccaoSelect + ", " + q.rank + " AS score"
+}
+
+// orderBy ordena pelo score e, no empate ou sem score, pelo CNPJ.
+func (q *prospeccaoQuery) orderBy() string {
+	if q.rank == "" {
+		return " ORDER BY e.cnpj ASC"
 	}
-	if uf, ok := filters["uf"].(string); ok && uf != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.uf = $%d", argCounter))
-		args = append(args, uf)
-		argCounter++
+	return " ORDER BY score DESC, e.cnpj ASC"
+}
+
+// prospeccaoFrom monta o FROM/WHERE da prospecção para os filtros informados. É compartilhado pela busca
+// com offset, pela busca por cursor e pela contagem.
+// Os filtros simples e a expressão de filters["where"] (um *Filter) são combinados com AND e compilados
+// por filterCompiler. Expressões inválidas ou acima dos limites retornam um *models.ValidationError.
+// filters["texto"] faz a busca textual em estabelecimento.busca (ver a migration 0006_busca_textual).
+// filters["nomeSimilar"] deixa só os nomes com similaridadeNome de pelo menos filters["nomeSimilarLimiar"]
+// (ver nomesSimilares); o score é o da busca textual quando as duas são informadas.
+func prospeccaoFrom(filters map[string]interface{}) (*prospeccaoQuery, error) {
+	root := &Filter{And: flatFilters(filters)}
+	if where, ok := filters["where"].(*Filter); ok && where != nil {
+		if err := checkLimits(where); err != nil {
+			return nil, err
+		}
+		root.And = append(root.And, where)
 	}
-	if situacaoCadastral, ok := filters["situacaoCadastral"].(string); ok && situacaoCadastral != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.situacao_cadastral = $%d", argCounter))
-		args = append(args, situacaoCadastral)
-		argCounter++
+	if root.And == nil {
+		root.And = []*Filter{}
 	}
-	if cnaeFiscal, ok := filters["cnaeFiscal"].(string); ok && cnaeFiscal != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.cnae_fiscal = $%d", argCounter))
-		args = append(args, cnaeFiscal)
-		argCounter++
+
+	c := &filterCompiler{}
+	cond, err := c.compile(root)
+	if err != nil {
+		return nil, err
 	}
-	if cnaeFiscalSecundaria, ok := filters["cnaeFiscalSecundaria"].(string); ok && cnaeFiscalSecundaria != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.cnae_fiscal_secundaria LIKE $%d", argCounter)) // Busca por substring
-		args = append(args, "%"+cnaeFiscalSecundaria+"%")
-		argCounter++
+
+	q := &prospeccaoQuery{from: `
+		FROM estabelecimento e
+		JOIN empresas emp ON e.cnpj_basico = emp.cnpj_basico
+	`}
+	// A tabela simples só entra quando algum filtro usa Simples/MEI. Ela só tem as empresas que já optaram;
+	// com o LEFT JOIN, as demais ficam com as colunas NULL e só passam nas exclusões (not).
+	if c.simples {
+		q.from += "LEFT JOIN simples s ON s.cnpj_basico = e.cnpj_basico "
 	}
-	if razaoSocial, ok := filters["razaoSocial"].(string); ok && razaoSocial != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND emp.razao_social ILIKE $%d", argCounter))
-		args = append(args, "%"+razaoSocial+"%")
-		argCounter++
+	q.from += "WHERE " + cond
+
+	// websearch_to_tsquery aceita o texto como o usuário digita: palavras, "frase exata", or e -exclusão.
+	if texto, ok := filters["texto"].(string); ok && strings.TrimSpace(texto) != "" {
+		tsquery := fmt.Sprintf("websearch_to_tsquery('busca_pt', %s)", c.arg(texto))
+		q.from += " AND e.busca @@ " + tsquery
+		q.rank = "ts_rank(e.busca, " + tsquery + ")"
 	}
-	if porteEmpresa, ok := filters["porteEmpresa"].(string); ok && porteEmpresa != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND emp.porte_empresa = $%d", argCounter))
-		args = append(args, porteEmpresa)
-		argCounter++
+	if nome, ok := filters["nomeSimilar"].(string); ok && strings.TrimSpace(nome) != "" {
+		arg := c.arg(nome)
+		q.from += " AND e.id IN (" + nomesSimilares(arg, "") + ")"
+		q.limiar, _ = filters["nomeSimilarLimiar"].(float64)
+		if q.rank == "" {
+			q.rank = similaridadeNome(arg)
+		}
 	}
-	if minCapitalSocial, ok := filters["minCapitalSocial"].(float64); ok && minCapitalSocial >= 0 {
-		queryParts = append(queryParts, fmt.Sprintf(" AND emp.capital_social >= $%d", argCounter))
-		args = append(args, minCapitalSocial)
-		argCounter++
+	q.args = c.args
+	return q, nil
+}
+
+// FindEstabelecimentosByFilters busca estabelecimentos com base em múltiplos critérios de filtro.
+// Retorna uma lista de EstabelecimentoComEmpresa, que inclui os dados de Empresa já carregados via JOIN.
+// Com busca textual, os resultados vêm do mais para o menos relevante.
+func (r *estabelecimentoRepository) FindEstabelecimentosByFilters(filters map[string]interface{}, limit *int, offset *int) ([]*EstabelecimentoComEmpresa, error) {
+	q, err := prospeccaoFrom(filters)
+	if err != nil {
+		return nil, err
 	}
-	if maxCapitalSocial, ok := filters["maxCapitalSocial"].(float64); ok && maxCapitalSocial >= 0 {
-		queryParts = append(queryParts, fmt.Sprintf(" AND emp.capital_social <= $%d", argCounter))
-		args = append(args, maxCapitalSocial)
+	args := q.args
+	argCounter := len(args) + 1
+	fullQuery := "SELECT " + q.columns() + q.from + q.orderBy()
+
+	if limit != nil && *limit > 0 {
+		fullQuery += fmt.Sprintf(" LIMIT $%d", argCounter)
+		args = append(args, *limit)
 		argCounter++
 	}
-	if municipio, ok := filters["municipio"].(string); ok && municipio != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.municipio ILIKE $%d", argCounter))
-		args = append(args, "%"+municipio+"%")
+	if offset != nil && *offset >= 0 {
+		fullQuery += fmt.Sprintf(" OFFSET $%d", argCounter)
+		args = append(args, *offset)
 		argCounter++
 	}
-	if naturezaJuridica, ok := filters["naturezaJuridica"].(string); ok && naturezaJuridica != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND emp.natureza_juridica = $%d", argCounter))
-		args = append(args, naturezaJuridica)
-		argCounter++
+	return r.selectProspeccao(q.limiar, fullQuery, args)
+}
+
+// ProspeccaoCursor é a posição de um resultado na ordem da prospecção, usada na paginação keyset.
+type ProspeccaoCursor struct {
+	CNPJ  string
+	Score *float64 // Score da busca (um float4); obrigatório quando filters["texto"] ou filters["nomeSimilar"] é informado
+}
+
+// FindEstabelecimentosByFiltersAfter é a variante keyset de FindEstabelecimentosByFilters: devolve até
+// limit estabelecimentos depois de after (ou desde o início, se after for nil), na mesma ordem.
+// Ao contrário do OFFSET, o custo não cresce com a profundidade da página (sem busca textual, usa
+// estabelecimento_cnpj_idx) e uma recarga dos dados não desloca os resultados já vistos.
+func (r *estabelecimentoRepository) FindEstabelecimentosByFiltersAfter(filters map[string]interface{}, after *ProspeccaoCursor, limit int) ([]*EstabelecimentoComEmpresa, error) {
+	q, err := prospeccaoFrom(filters)
+	if err != nil {
+		return nil, err
 	}
-	if dataSituacaoCadastralMin, ok := filters["dataSituacaoCadastralMin"].(string); ok && dataSituacaoCadastralMin != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.data_situacao_cadastral >= $%d", argCounter))
-		args = append(args, dataSituacaoCadastralMin)
-		argCounter++
+	query, args, err := q.keyset(after, limit)
+	if err != nil {
+		return nil, err
 	}
-	if dataSituacaoCadastralMax, ok := filters["dataSituacaoCadastralMax"].(string); ok && dataSituacaoCadastralMax != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.data_situacao_cadastral <= $%d", argCounter))
-		args = append(args, dataSituacaoCadastralMax)
-		argCounter++
+	return r.selectProspeccao(q.limiar, query, args)
+}
+
+// keyset monta a consulta de uma página depois de after. Na busca textual a posição é o score e o CNPJ;
+// o score é comparado como float4, o tipo do ts_rank, para que o valor que volta no cursor (gravado com a
+// precisão de float4) seja exatamente o da linha e nenhum resultado se repita ou suma entre as páginas.
+func (q *prospeccaoQuery) keyset(after *ProspeccaoCursor, limit int) (string, []interface{}, error) {
+	args := append([]interface{}{}, q.args...)
+	query := "SELECT " + q.columns() + q.from
+
+	if after != nil {
+		args = append(args, after.CNPJ)
+		cnpjArg := len(args)
+		if q.rank == "" {
+			query += fmt.Sprintf(" AND e.cnpj > $%d", cnpjArg)
+		} else {
+			if after.Score == nil {
+				return "", nil, &models.ValidationError{Field: "after", Value: after.CNPJ, Reason: "o cursor não é de uma busca textual"}
+			}
+			args = append(args, *after.Score)
+			rank := "(" + q.rank + ")::float4"
+			query += fmt.Sprintf(" AND (%s < $%d::float4 OR (%s = $%d::float4 AND e.cnpj > $%d))", rank, len(args), rank, len(args), cnpjArg)
+		}
 	}
-	if dataInicioAtividadesMin, ok := filters["dataInicioAtividadesMin"].(string); ok && dataInicioAtividadesMin != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.data_inicio_atividades >= $%d", argCounter))
-		args = append(args, dataInicioAtividadesMin)
-		argCounter++
+	args = append(args, limit)
+	query += q.orderBy() + fmt.Sprintf(" LIMIT $%d", len(args))
+	return query, args, nil
+}
+
+// CountEstabelecimentosByFilters conta os estabelecimentos que atendem aos filtros.
+func (r *estabelecimentoRepository) CountEstabelecimentosByFilters(filters map[string]interface{}) (int, error) {
+	q, err := prospeccaoFrom(filters)
+	if err != nil {
+		return 0, err
 	}
-	if dataInicioAtividadesMax, ok := filters["dataInicioAtividadesMax"].(string); ok && dataInicioAtividadesMax != "" {
-		queryParts = append(queryParts, fmt.Sprintf(" AND e.data_inicio_atividades <= $%d", argCounter))
-		args = append(args, dataInicioAtividadesMax)
-		argCounter++
+	var total int
+	err = r.withLimiar(q.limiar, func(db sqlx.Queryer) error {
+		return sqlx.Get(db, &total, "SELECT count(*)"+q.from, q.args...)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("erro ao contar estabelecimentos com filtros: %w", err)
 	}
+	return total, nil
+}
 
-	fullQuery := strings.Join(queryParts, " ") + " ORDER BY e.cnpj ASC"
+// similaridadeNome é a similaridade de trigramas (0 a 1) entre o nome em arg e o estabelecimento: a maior
+// entre a da razão social e a do nome fantasia. O pg_trgm não diferencia maiúsculas.
+func similaridadeNome(arg string) string {
+	return "GREATEST(similarity(emp.razao_social, " + arg + "), similarity(e.nome_fantasia, " + arg + "))"
+}
 
-	if limit != nil && *limit > 0 {
-		fullQuery += fmt.Sprintf(" LIMIT $%d", argCounter)
-		args = append(args, *limit)
-		argCounter++
+// nomesSimilares é a consulta dos ids dos estabelecimentos com nome fantasia ou razão social parecidos com
+// o nome em arg, pelo operador % (similaridade de pelo menos pg_trgm.similarity_threshold; ver withLimiar).
+// Cada lado do UNION usa o seu índice de trigramas da migration 0002_indices_prospeccao; comparar as duas
+// colunas no mesmo WHERE (OR) impediria o uso dos índices. ufCond é acrescentada aos dois lados.
+func nomesSimilares(arg, ufCond string) string {
+	return `
+			SELECT e.id FROM estabelecimento e
+			WHERE e.nome_fantasia % ` + arg + ufCond + `
+			UNION
+			SELECT e.id FROM empresas emp
+			JOIN estabelecimento e ON e.cnpj_basico = emp.cnpj_basico
+			WHERE emp.razao_social % ` + arg + ufCond
+}
+
+// withLimiar executa fn em uma transação com pg_trgm.similarity_threshold igual a limiar, o limiar do
+// operador % (o valor vale só dentro da transação). Com limiar 0, fn usa o pool e vale o padrão do pg_trgm.
+func (r *estabelecimentoRepository) withLimiar(limiar float64, fn func(db sqlx.Queryer) error) error {
+	if limiar <= 0 {
+		return fn(r.db)
 	}
-	if offset != nil && *offset >= 0 {
-		fullQuery += fmt.Sprintf(" OFFSET $%d", argCounter)
-		args = append(args, *offset)
-		argCounter++
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar a busca por similaridade: %w", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec("SELECT set_config('pg_trgm.similarity_threshold', $1, true)", strconv.FormatFloat(limiar, 'f', -1, 64)); err != nil {
+		return fmt.Errorf("erro ao configurar o limiar de similaridade: %w", err)
 	}
+	return fn(tx)
+}
+
+// FindEmpresasSimilares busca os estabelecimentos cuja razão social ou nome fantasia é parecido com nome,
+// pela similaridade de trigramas do pg_trgm (ver similaridadeNome). Só entram os candidatos com
+// similaridade de pelo menos limiar (0 a 1), do mais para o menos parecido; o score de cada um é a maior
+// das duas similaridades. uf vazia não filtra.
+func (r *estabelecimentoRepository) FindEmpresasSimilares(nome, uf string, limiar float64, limit int) ([]*EstabelecimentoComEmpresa, error) {
+	args := []interface{}{nome}
+	ufCond := ""
+	if uf != "" {
+		args = append(args, uf)
+		ufCond = " AND e.uf = $2"
+	}
+	args = append(args, limit)
+	query := `
+		WITH candidatos AS (` + nomesSimilares("$1", ufCond) + `
+		)
+		SELECT ` + prospeccaoSelect + `,
+			` + similaridadeNome("$1") + ` AS score
+		FROM candidatos c
+		JOIN estabelecimento e ON e.id = c.id
+		JOIN empresas emp ON e.cnpj_basico = emp.cnpj_basico
+		ORDER BY score DESC, e.cnpj ASC` + fmt.Sprintf(" LIMIT $%d", len(args))
+
+	var results []estabelecimentoWithEmpresa
+	err := r.withLimiar(limiar, func(db sqlx.Queryer) error {
+		return sqlx.Select(db, &results, query, args...)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar empresas similares: %w", err)
+	}
+	finalResults := make([]*EstabelecimentoComEmpresa, len(results))
+	for i := range results {
+		results[i].Estabelecimento.FormatCNPJ()
+		e := EstabelecimentoComEmpresa(results[i])
+		finalResults[i] = &e
+	}
+	return finalResults, nil
+}
+
+// selectProspeccao executa uma busca da prospecção, com o limiar da busca por nome parecido, e formata
+// os CNPJs.
+func (r *estabelecimentoRepository) selectProspeccao(limiar float64, query string, args []interface{}) ([]*EstabelecimentoComEmpresa, error) {
+	var results []estabelecimentoWithEmpresa // Vamos escanear para esta slice de structs combinadas
 
 	// Usamos sqlx.Select para escanear diretamente para a slice da struct combinada.
-	err := r.db.Select(&results, fullQuery, args...)
+	err := r.withLimiar(limiar, func(db sqlx.Queryer) error {
+		return sqlx.Select(db, &results, query, args...)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("erro ao consultar estabelecimentos com filtros: %w", err)
 	}
@@ -419,3 +510,10 @@ func (r *estabelecimentoRepository) FindEstabelecimentosByFilters(filters map[st
 
 	return finalResults, nil
 }
+
+// receitaDate normaliza uma coluna de data para YYYYMMDD, ou NULL se a data estiver ausente, qualquer que seja
+// a forma como foi gravada: YYYYMMDD (arquivos da Receita), YYYY-MM-DD, DATE ou os marcadores "", "0" e "00000000".
+// Assim a comparação com o limite do filtro (também YYYYMMDD) é cronológica e datas ausentes nunca passam.
+func receitaDate(column string) string {
+	return fmt.Sprintf("NULLIF(NULLIF(NULLIF(replace(%s::text, '-', ''), ''), '0'), '00000000')", column)
+}
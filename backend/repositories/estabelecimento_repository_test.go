@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+)
+
+func TestProspeccaoKeyset(t *testing.T) {
+	score := 0.25
+	q, err := prospeccaoFrom(map[string]interface{}{"uf": "SP"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	query, args, err := q.keyset(nil, 21)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !strings.HasSuffix(query, "WHERE (e.uf = $1) ORDER BY e.cnpj ASC LIMIT $2") || !reflect.DeepEqual(args, []interface{}{"SP", 21}) {
+		t.Errorf("primeira página: %q %v", query, args)
+	}
+
+	query, args, err = q.keyset(&ProspeccaoCursor{CNPJ: "33000167000101"}, 21)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !strings.HasSuffix(query, "WHERE (e.uf = $1) AND e.cnpj > $2 ORDER BY e.cnpj ASC LIMIT $3") ||
+		!reflect.DeepEqual(args, []interface{}{"SP", "33000167000101", 21}) {
+		t.Errorf("página seguinte: %q %v", query, args)
+	}
+	if len(q.args) != 1 {
+		t.Errorf("keyset alterou os argumentos da consulta base: %v", q.args)
+	}
+
+	q, err = prospeccaoFrom(map[string]interface{}{"texto": "padaria"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	query, args, err = q.keyset(&ProspeccaoCursor{CNPJ: "33000167000101", Score: &score}, 21)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	rank := "(ts_rank(e.busca, websearch_to_tsquery('busca_pt', $1)))::float4"
+	want := " AND (" + rank + " < $3::float4 OR (" + rank + " = $3::float4 AND e.cnpj > $2)) ORDER BY score DESC, e.cnpj ASC LIMIT $4"
+	if !strings.HasSuffix(query, want) || !reflect.DeepEqual(args, []interface{}{"padaria", "33000167000101", 0.25, 21}) {
+		t.Errorf("busca textual: %q %v, esperado terminar com %q", query, args, want)
+	}
+
+	// Cursor de uma busca sem texto usado em uma busca textual.
+	_, _, err = q.keyset(&ProspeccaoCursor{CNPJ: "33000167000101"}, 21)
+	var verr *models.ValidationError
+	if !errors.As(err, &verr) || verr.Field != "after" {
+		t.Errorf("cursor sem score: erro = %v, esperado um ValidationError em after", err)
+	}
+}
+
+func TestProspeccaoNomeSimilar(t *testing.T) {
+	q, err := prospeccaoFrom(map[string]interface{}{"uf": "SP", "nomeSimilar": "padaria sao jose", "nomeSimilarLimiar": 0.4})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	// O filtro é o operador %, que usa os índices de trigramas, e não a comparação de similarity().
+	if !strings.HasSuffix(q.from, "WHERE (e.uf = $1) AND e.id IN ("+nomesSimilares("$2", "")+")") {
+		t.Errorf("from = %q", q.from)
+	}
+	if strings.Count(q.from, "% $2") != 2 || strings.Contains(q.from, "similarity(") {
+		t.Errorf("from sem o operador %% nas duas colunas: %q", q.from)
+	}
+	similaridade := "GREATEST(similarity(emp.razao_social, $2), similarity(e.nome_fantasia, $2))"
+	if q.rank != similaridade || q.limiar != 0.4 || !reflect.DeepEqual(q.args, []interface{}{"SP", "padaria sao jose"}) {
+		t.Errorf("rank = %q, limiar = %v, args = %v", q.rank, q.limiar, q.args)
+	}
+
+	// Com busca textual, o score continua sendo o ts_rank.
+	q, err = prospeccaoFrom(map[string]interface{}{"texto": "padaria", "nomeSimilar": "sao jose", "nomeSimilarLimiar": 0.3})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if q.rank != "ts_rank(e.busca, websearch_to_tsquery('busca_pt', $1))" {
+		t.Errorf("rank com busca textual = %q", q.rank)
+	}
+}
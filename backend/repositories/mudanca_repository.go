@@ -0,0 +1,92 @@
+// neurocloser/backend/repositories/mudanca_repository.go
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// MudancaRepository define a interface para consultas ao log de mudanças entre cargas.
+type MudancaRepository interface {
+	FindMudancas(filters map[string]interface{}, limit *int, offset *int) ([]*models.Mudanca, error)
+}
+
+// mudancaRepository implementa MudancaRepository para PostgreSQL.
+type mudancaRepository struct {
+	db *sqlx.DB
+}
+
+// NewMudancaRepository cria uma nova instância de MudancaRepository.
+func NewMudancaRepository(db *sqlx.DB) MudancaRepository {
+	return &mudancaRepository{db: db}
+}
+
+// FindMudancas busca mudanças por tipo, UF, CNAE, mês de referência da carga (filters["referencia"]) e
+// período (filters["dataInicio"] e filters["dataFim"], do tipo models.Date), das mais recentes para as mais antigas.
+func (r *mudancaRepository) FindMudancas(filters map[string]interface{}, limit *int, offset *int) ([]*models.Mudanca, error) {
+	mudancas := []*models.Mudanca{}
+
+	baseQuery := `
+		SELECT
+			id, tipo, cnpj, cnpj_basico, uf, cnae_fiscal, valor_anterior, valor_novo, detectada_em, referencia
+		FROM mudancas
+		WHERE 1=1
+	`
+	queryParts := []string{baseQuery}
+	args := []interface{}{}
+	argCounter := 1
+
+	if tipos, ok := filters["tipos"].([]string); ok && len(tipos) > 0 {
+		queryParts = append(queryParts, fmt.Sprintf(" AND tipo = ANY($%d)", argCounter))
+		args = append(args, pq.Array(tipos))
+		argCounter++
+	}
+	if uf, ok := filters["uf"].(string); ok && uf != "" {
+		queryParts = append(queryParts, fmt.Sprintf(" AND uf = $%d", argCounter))
+		args = append(args, uf)
+		argCounter++
+	}
+	if cnaeFiscal, ok := filters["cnaeFiscal"].(string); ok && cnaeFiscal != "" {
+		queryParts = append(queryParts, fmt.Sprintf(" AND cnae_fiscal = $%d", argCounter))
+		args = append(args, cnaeFiscal)
+		argCounter++
+	}
+	if referencia, ok := filters["referencia"].(string); ok && referencia != "" {
+		queryParts = append(queryParts, fmt.Sprintf(" AND referencia = $%d", argCounter))
+		args = append(args, referencia)
+		argCounter++
+	}
+	if dataInicio, ok := filters["dataInicio"].(models.Date); ok && dataInicio.Valid {
+		queryParts = append(queryParts, fmt.Sprintf(" AND detectada_em >= $%d", argCounter))
+		args = append(args, dataInicio.String())
+		argCounter++
+	}
+	if dataFim, ok := filters["dataFim"].(models.Date); ok && dataFim.Valid {
+		queryParts = append(queryParts, fmt.Sprintf(" AND detectada_em <= $%d", argCounter))
+		args = append(args, dataFim.String())
+		argCounter++
+	}
+
+	fullQuery := strings.Join(queryParts, " ") + " ORDER BY detectada_em DESC, id ASC"
+
+	if limit != nil && *limit > 0 {
+		fullQuery += fmt.Sprintf(" LIMIT $%d", argCounter)
+		args = append(args, *limit)
+		argCounter++
+	}
+	if offset != nil && *offset >= 0 {
+		fullQuery += fmt.Sprintf(" OFFSET $%d", argCounter)
+		args = append(args, *offset)
+	}
+
+	err := r.db.Select(&mudancas, fullQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao consultar mudanças com filtros: %w", err)
+	}
+	return mudancas, nil
+}
@@ -0,0 +1,455 @@
+// neurocloser/backend/repositories/prospeccao_filter.go
+package repositories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/lib/pq"
+)
+
+// Limites das expressões de filtro recebidas da API (filters["where"]), para que uma expressão
+// muito grande não vire uma consulta cara demais.
+const (
+	MaxFilterDepth  = 6  // Níveis de and/or/not, contando a raiz
+	MaxFilterLeaves = 50 // Predicados na expressão inteira
+)
+
+// FilterOp é o operador de um predicado de Filter.
+type FilterOp int
+
+const (
+	OpEq       FilterOp = iota // campo = Value
+	OpIn                       // campo é um dos Values
+	OpRange                    // Min <= campo <= Max (um dos limites pode faltar)
+	OpPrefix                   // campo começa com Value (sem diferenciar maiúsculas)
+	OpContains                 // campo contém Value (sem diferenciar maiúsculas)
+)
+
+// Filter é uma expressão de filtro da prospecção. Cada nó é exatamente um entre And, Or, Not
+// e um predicado (Field com Op). Os valores chegam como texto, no formato da API (datas em YYYY-MM-DD),
+// e são convertidos conforme o tipo do campo.
+type Filter struct {
+	And []*Filter
+	Or  []*Filter
+	Not *Filter
+
+	Field  string // Campo de prospeccaoFields
+	Op     FilterOp
+	Value  string   // OpEq, OpPrefix e OpContains
+	Values []string // OpIn
+	Min    *string  // OpRange
+	Max    *string  // OpRange
+}
+
+// fieldKind é o tipo de um campo filtrável, que define como os valores são convertidos e comparados.
+type fieldKind int
+
+const (
+	textField fieldKind = iota
+	numberField
+	dateField // Comparado no formato YYYYMMDD (ver receitaDate)
+)
+
+// prospeccaoFields são os únicos campos aceitos nos filtros. As colunas da tabela simples (alias s)
+// fazem a consulta incluir o LEFT JOIN com simples. Os predicados sobre os CNAEs secundários (alias ecs)
+// valem para cada código de estabelecimento_cnae_secundaria: o estabelecimento passa se algum atender.
+var prospeccaoFields = map[string]struct {
+	column string
+	kind   fieldKind
+}{
+	"cnpj":                  {"e.cnpj", textField},
+	"cnpjBasico":            {"e.cnpj_basico", textField},
+	"nomeFantasia":          {"e.nome_fantasia", textField},
+	"matrizFilial":          {"e.matriz_filial", textField},
+	"situacaoCadastral":     {"e.situacao_cadastral", textField},
+	"dataSituacaoCadastral": {"e.data_situacao_cadastral", dateField},
+	"dataInicioAtividades":  {"e.data_inicio_atividades", dateField},
+	"cnaeFiscal":            {"e.cnae_fiscal", textField},
+	"cnaeFiscalSecundaria":  {"ecs.cnae", textField},
+	"uf":                    {"e.uf", textField},
+	"municipio":             {"e.municipio", textField},
+	"razaoSocial":           {"emp.razao_social", textField},
+	"naturezaJuridica":      {"emp.natureza_juridica", textField},
+	"porteEmpresa":          {"emp.porte_empresa", textField},
+	"capitalSocial":         {"emp.capital_social", numberField},
+	"opcaoSimples":          {"s.opcao_simples", textField},
+	"dataOpcaoSimples":      {"s.data_opcao_simples", dateField},
+	"dataExclusaoSimples":   {"s.data_exclusao_simples", dateField},
+	"opcaoMEI":              {"s.opcao_mei", textField},
+	"dataOpcaoMEI":          {"s.data_opcao_mei", dateField},
+	"dataExclusaoMEI":       {"s.data_exclusao_mei", dateField},
+}
+
+// codeWidths são os campos com códigos da Receita de tamanho fixo. Algumas exportações perdem o zero à
+// esquerda ("2" em vez de "02"), e a API aceita as duas formas na saída (ver graphql.enumFromCode); nos
+// predicados eq e in cada código é comparado nas duas formas, com = ANY para continuar usando os índices.
+var codeWidths = map[string]int{
+	"situacaoCadastral": 2,
+	"porteEmpresa":      2,
+}
+
+// codeVariants devolve os códigos com e sem o zero à esquerda, sem repetições.
+func codeVariants(values []string, width int) []string {
+	var variants []string
+	seen := map[string]bool{}
+	add := func(v string) {
+		if !seen[v] {
+			seen[v] = true
+			variants = append(variants, v)
+		}
+	}
+	for _, v := range values {
+		add(v)
+		switch {
+		case len(v) == width && v[0] == '0':
+			add(v[1:])
+		case len(v) == width-1:
+			add("0" + v)
+		}
+	}
+	return variants
+}
+
+// Chaves dos filtros simples de FindEstabelecimentosByFilters, por operador. As de listas ganham os
+// sufixos In/NotIn e as de datas, Min/Max.
+var (
+	eqFilterKeys       = []string{"cnpj", "uf", "situacaoCadastral", "matrizFilial", "cnaeFiscal", "cnaeFiscalSecundaria", "porteEmpresa", "naturezaJuridica", "opcaoSimples", "opcaoMEI"}
+	containsFilterKeys = []string{"nomeFantasia", "razaoSocial", "municipio"}
+	listFilterKeys     = []string{"uf", "municipio", "situacaoCadastral", "cnaeFiscal", "porteEmpresa", "naturezaJuridica"}
+	dateFilterKeys     = []string{"dataSituacaoCadastral", "dataInicioAtividades", "dataOpcaoSimples", "dataExclusaoSimples", "dataOpcaoMEI", "dataExclusaoMEI"}
+)
+
+// Modos do filtro por lista de CNAEs (filters["cnaes"], com o modo em filters["cnaesModo"]).
+const (
+	CNAEsQualquer  = "qualquer"  // O CNAE principal ou algum secundário é um dos códigos (padrão)
+	CNAEsTodos     = "todos"     // Todos os códigos estão entre o principal e os secundários
+	CNAEsPrincipal = "principal" // O CNAE principal é um dos códigos
+)
+
+// cnaesFilter monta o predicado do filtro por lista de CNAEs no modo informado.
+func cnaesFilter(codigos []string, modo string) *Filter {
+	principal := &Filter{Field: "cnaeFiscal", Op: OpIn, Values: codigos}
+	switch modo {
+	case CNAEsPrincipal:
+		return principal
+	case CNAEsTodos:
+		all := &Filter{And: make([]*Filter, len(codigos))}
+		for i, codigo := range codigos {
+			all.And[i] = &Filter{Or: []*Filter{
+				{Field: "cnaeFiscal", Op: OpEq, Value: codigo},
+				{Field: "cnaeFiscalSecundaria", Op: OpEq, Value: codigo},
+			}}
+		}
+		return all
+	}
+	return &Filter{Or: []*Filter{principal, {Field: "cnaeFiscalSecundaria", Op: OpIn, Values: codigos}}}
+}
+
+// flatFilters converte o mapa de filtros simples de FindEstabelecimentosByFilters em predicados,
+// que são combinados com AND (e com a expressão de filters["where"], se houver).
+func flatFilters(filters map[string]interface{}) []*Filter {
+	var preds []*Filter
+	for _, key := range eqFilterKeys {
+		if v, ok := filters[key].(string); ok && v != "" {
+			preds = append(preds, &Filter{Field: key, Op: OpEq, Value: v})
+		}
+	}
+	for _, key := range containsFilterKeys {
+		if v, ok := filters[key].(string); ok && v != "" {
+			preds = append(preds, &Filter{Field: key, Op: OpContains, Value: v})
+		}
+	}
+	for _, key := range listFilterKeys {
+		if values, ok := filters[key+"In"].([]string); ok && len(values) > 0 {
+			preds = append(preds, &Filter{Field: key, Op: OpIn, Values: values})
+		}
+		if values, ok := filters[key+"NotIn"].([]string); ok && len(values) > 0 {
+			preds = append(preds, &Filter{Not: &Filter{Field: key, Op: OpIn, Values: values}})
+		}
+	}
+
+	if codigos, ok := filters["cnaes"].([]string); ok && len(codigos) > 0 {
+		modo, _ := filters["cnaesModo"].(string)
+		preds = append(preds, cnaesFilter(codigos, modo))
+	}
+
+	capital := &Filter{Field: "capitalSocial", Op: OpRange}
+	if v, ok := filters["minCapitalSocial"].(float64); ok && v >= 0 {
+		s := strconv.FormatFloat(v, 'f', -1, 64)
+		capital.Min = &s
+	}
+	if v, ok := filters["maxCapitalSocial"].(float64); ok && v >= 0 {
+		s := strconv.FormatFloat(v, 'f', -1, 64)
+		capital.Max = &s
+	}
+	if capital.Min != nil || capital.Max != nil {
+		preds = append(preds, capital)
+	}
+
+	for _, key := range dateFilterKeys {
+		date := &Filter{Field: key, Op: OpRange}
+		if d, ok := filters[key+"Min"].(models.Date); ok && d.Valid {
+			s := d.String()
+			date.Min = &s
+		}
+		if d, ok := filters[key+"Max"].(models.Date); ok && d.Valid {
+			s := d.String()
+			date.Max = &s
+		}
+		if date.Min != nil || date.Max != nil {
+			preds = append(preds, date)
+		}
+	}
+	return preds
+}
+
+// filterCompiler traduz um Filter para SQL. Os valores nunca entram no texto da consulta: cada um vira
+// um argumento posicional ($1, $2...) e os campos só podem ser os de prospeccaoFields.
+type filterCompiler struct {
+	args    []interface{}
+	simples bool // Algum predicado usa a tabela simples
+}
+
+// arg acrescenta um argumento e devolve o placeholder correspondente.
+func (c *filterCompiler) arg(v interface{}) string {
+	c.args = append(c.args, v)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+// filterError é o erro de validação de uma expressão de filtro.
+func filterError(value, reason string) error {
+	return &models.ValidationError{Field: "where", Value: value, Reason: reason}
+}
+
+// FilterDepthError e FilterLeavesError são os erros de uma expressão acima de MaxFilterDepth e
+// MaxFilterLeaves. Quem monta a expressão a partir da entrada da API pode usá-los para parar antes.
+func FilterDepthError(depth int) error {
+	return filterError(strconv.Itoa(depth), fmt.Sprintf("a expressão de filtro pode ter no máximo %d níveis", MaxFilterDepth))
+}
+
+func FilterLeavesError(leaves int) error {
+	return filterError(strconv.Itoa(leaves), fmt.Sprintf("a expressão de filtro pode ter no máximo %d predicados", MaxFilterLeaves))
+}
+
+// checkLimits verifica a profundidade e a quantidade de predicados de uma expressão recebida da API.
+func checkLimits(f *Filter) error {
+	var leaves int
+	var walk func(f *Filter, depth int) error
+	walk = func(f *Filter, depth int) error {
+		if depth > MaxFilterDepth {
+			return FilterDepthError(depth)
+		}
+		if f.Field != "" {
+			if leaves++; leaves > MaxFilterLeaves {
+				return FilterLeavesError(leaves)
+			}
+		}
+		children := append(append([]*Filter{}, f.And...), f.Or...)
+		if f.Not != nil {
+			children = append(children, f.Not)
+		}
+		for _, child := range children {
+			if child == nil {
+				continue
+			}
+			if err := walk(child, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(f, 1)
+}
+
+// compile devolve a condição SQL de f.
+func (c *filterCompiler) compile(f *Filter) (string, error) {
+	kinds := 0
+	for _, set := range []bool{f.And != nil, f.Or != nil, f.Not != nil, f.Field != ""} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return "", filterError("", "cada nó da expressão de filtro deve ter exatamente um entre and, or, not e um predicado")
+	}
+
+	switch {
+	case f.And != nil:
+		return c.join(f.And, " AND ", "TRUE")
+	case f.Or != nil:
+		if len(f.Or) == 0 {
+			return "", filterError("or", "or precisa de ao menos uma expressão")
+		}
+		return c.join(f.Or, " OR ", "")
+	case f.Not != nil:
+		cond, err := c.compile(f.Not)
+		if err != nil {
+			return "", err
+		}
+		// Um predicado sobre coluna NULL (ou sobre simples, em empresas que nunca optaram) dá NULL;
+		// o COALESCE faz o NOT incluir essas linhas, como se espera de uma exclusão.
+		return "NOT COALESCE((" + cond + "), false)", nil
+	default:
+		return c.leaf(f)
+	}
+}
+
+// join compila as expressões e as une com sep. Uma lista vazia vira empty.
+func (c *filterCompiler) join(fs []*Filter, sep, empty string) (string, error) {
+	if len(fs) == 0 {
+		return empty, nil
+	}
+	conds := make([]string, len(fs))
+	for i, child := range fs {
+		if child == nil {
+			return "", filterError("", "expressão de filtro vazia")
+		}
+		cond, err := c.compile(child)
+		if err != nil {
+			return "", err
+		}
+		conds[i] = cond
+	}
+	return "(" + strings.Join(conds, sep) + ")", nil
+}
+
+// leaf compila um predicado.
+func (c *filterCompiler) leaf(f *Filter) (string, error) {
+	field, ok := prospeccaoFields[f.Field]
+	if !ok {
+		return "", filterError(f.Field, "campo não permitido em filtros")
+	}
+	if strings.HasPrefix(field.column, "s.") {
+		c.simples = true
+	}
+	cond, err := c.predicate(f, field.column, field.kind)
+	if err != nil {
+		return "", err
+	}
+	// A subconsulta não é correlacionada: o PostgreSQL pode fazer um semi-join pelo índice
+	// estabelecimento_cnae_secundaria_cnae_idx ou, dentro de um or, calculá-la uma vez só.
+	if strings.HasPrefix(field.column, "ecs.") {
+		cond = "e.cnpj IN (SELECT ecs.cnpj FROM estabelecimento_cnae_secundaria ecs WHERE " + cond + ")"
+	}
+	return cond, nil
+}
+
+// predicate compila a condição de um predicado sobre a coluna informada.
+func (c *filterCompiler) predicate(f *Filter, column string, kind fieldKind) (string, error) {
+	if kind == dateField {
+		column = receitaDate(column)
+	}
+
+	if width, ok := codeWidths[f.Field]; ok && (f.Op == OpEq || f.Op == OpIn) {
+		values := f.Values
+		if f.Op == OpEq {
+			values = []string{f.Value}
+		} else if len(values) == 0 {
+			return "", filterError(f.Field, "in precisa de ao menos um valor")
+		}
+		return column + " = ANY(" + c.arg(pq.Array(codeVariants(values, width))) + ")", nil
+	}
+
+	switch f.Op {
+	case OpEq:
+		v, err := filterValue(f.Field, kind, f.Value)
+		if err != nil {
+			return "", err
+		}
+		return column + " = " + c.arg(v), nil
+
+	case OpIn:
+		if len(f.Values) == 0 {
+			return "", filterError(f.Field, "in precisa de ao menos um valor")
+		}
+		values, err := filterArray(f.Field, kind, f.Values)
+		if err != nil {
+			return "", err
+		}
+		return column + " = ANY(" + c.arg(values) + ")", nil
+
+	case OpRange:
+		if f.Min == nil && f.Max == nil {
+			return "", filterError(f.Field, "range precisa de min, max ou ambos")
+		}
+		var conds []string
+		if f.Min != nil {
+			v, err := filterValue(f.Field, kind, *f.Min)
+			if err != nil {
+				return "", err
+			}
+			conds = append(conds, column+" >= "+c.arg(v))
+		}
+		if f.Max != nil {
+			v, err := filterValue(f.Field, kind, *f.Max)
+			if err != nil {
+				return "", err
+			}
+			conds = append(conds, column+" <= "+c.arg(v))
+		}
+		return "(" + strings.Join(conds, " AND ") + ")", nil
+
+	case OpPrefix, OpContains:
+		if kind != textField {
+			return "", filterError(f.Field, "prefix e contains só valem para campos de texto")
+		}
+		pattern := escapeLike(f.Value) + "%"
+		if f.Op == OpContains {
+			pattern = "%" + pattern
+		}
+		return column + " ILIKE " + c.arg(pattern), nil
+	}
+	return "", filterError(f.Field, "operador de filtro desconhecido")
+}
+
+// filterValue converte o valor de um predicado para o tipo do campo.
+func filterValue(name string, kind fieldKind, v string) (interface{}, error) {
+	switch kind {
+	case numberField:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, filterError(v, fmt.Sprintf("%s espera um número", name))
+		}
+		return n, nil
+	case dateField:
+		d, err := models.ParseReceitaDate(v)
+		if err != nil || !d.Valid {
+			return nil, filterError(v, fmt.Sprintf("%s espera uma data no formato YYYY-MM-DD", name))
+		}
+		return d.ReceitaString(), nil
+	}
+	return v, nil
+}
+
+// filterArray converte os valores de um predicado in para um array do PostgreSQL.
+func filterArray(name string, kind fieldKind, values []string) (interface{}, error) {
+	if kind == numberField {
+		numbers := make([]float64, len(values))
+		for i, v := range values {
+			n, err := filterValue(name, kind, v)
+			if err != nil {
+				return nil, err
+			}
+			numbers[i] = n.(float64)
+		}
+		return pq.Array(numbers), nil
+	}
+	texts := make([]string, len(values))
+	for i, v := range values {
+		t, err := filterValue(name, kind, v)
+		if err != nil {
+			return nil, err
+		}
+		texts[i] = t.(string)
+	}
+	return pq.Array(texts), nil
+}
+
+// escapeLike protege os curingas do LIKE (%, _ e a barra de escape) em um valor informado pelo usuário.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
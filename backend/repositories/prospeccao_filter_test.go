@@ -0,0 +1,300 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/lib/pq"
+)
+
+func str(s string) *string { return &s }
+
+func TestFilterCompilerLeaf(t *testing.T) {
+	dataInicio := "NULLIF(NULLIF(NULLIF(replace(e.data_inicio_atividades::text, '-', ''), ''), '0'), '00000000')"
+	tests := []struct {
+		name    string
+		f       *Filter
+		want    string
+		args    []interface{}
+		simples bool
+	}{
+		{"eq", &Filter{Field: "uf", Op: OpEq, Value: "SP"}, "e.uf = $1", []interface{}{"SP"}, false},
+		{"eq número", &Filter{Field: "capitalSocial", Op: OpEq, Value: " 1500.50 "}, "emp.capital_social = $1", []interface{}{1500.5}, false},
+		{"eq data", &Filter{Field: "dataInicioAtividades", Op: OpEq, Value: "2024-01-31"}, dataInicio + " = $1", []interface{}{"20240131"}, false},
+		{
+			"in texto",
+			&Filter{Field: "cnaeFiscal", Op: OpIn, Values: []string{"4711302", "5611201"}},
+			"e.cnae_fiscal = ANY($1)", []interface{}{pq.Array([]string{"4711302", "5611201"})}, false,
+		},
+		{
+			"in número",
+			&Filter{Field: "capitalSocial", Op: OpIn, Values: []string{"10", "20.5"}},
+			"emp.capital_social = ANY($1)", []interface{}{pq.Array([]float64{10, 20.5})}, false,
+		},
+		{
+			"range",
+			&Filter{Field: "capitalSocial", Op: OpRange, Min: str("1000"), Max: str("5000")},
+			"(emp.capital_social >= $1 AND emp.capital_social <= $2)", []interface{}{1000.0, 5000.0}, false,
+		},
+		{
+			"range só com max",
+			&Filter{Field: "dataInicioAtividades", Op: OpRange, Max: str("2020-12-31")},
+			"(" + dataInicio + " <= $1)", []interface{}{"20201231"}, false,
+		},
+		{"prefix", &Filter{Field: "nomeFantasia", Op: OpPrefix, Value: "PADARIA"}, "e.nome_fantasia ILIKE $1", []interface{}{"PADARIA%"}, false},
+		{"contains", &Filter{Field: "razaoSocial", Op: OpContains, Value: "sao jose"}, "emp.razao_social ILIKE $1", []interface{}{"%sao jose%"}, false},
+		{"contains com curingas", &Filter{Field: "razaoSocial", Op: OpContains, Value: "50%_OFF"}, "emp.razao_social ILIKE $1", []interface{}{`%50\%\_OFF%`}, false},
+		{
+			"eq código sem zero",
+			&Filter{Field: "situacaoCadastral", Op: OpEq, Value: "02"},
+			"e.situacao_cadastral = ANY($1)", []interface{}{pq.Array([]string{"02", "2"})}, false,
+		},
+		{
+			"in código sem zero",
+			&Filter{Field: "porteEmpresa", Op: OpIn, Values: []string{"01", "5", "05"}},
+			"emp.porte_empresa = ANY($1)", []interface{}{pq.Array([]string{"01", "1", "5", "05"})}, false,
+		},
+		{"coluna do simples", &Filter{Field: "opcaoMEI", Op: OpEq, Value: "S"}, "s.opcao_mei = $1", []interface{}{"S"}, true},
+		{
+			"cnae secundário",
+			&Filter{Field: "cnaeFiscalSecundaria", Op: OpEq, Value: "4711"},
+			"e.cnpj IN (SELECT ecs.cnpj FROM estabelecimento_cnae_secundaria ecs WHERE ecs.cnae = $1)", []interface{}{"4711"}, false,
+		},
+	}
+	for _, tt := range tests {
+		c := &filterCompiler{}
+		got, err := c.compile(tt.f)
+		if err != nil {
+			t.Errorf("%s: erro inesperado: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: SQL = %q, esperado %q", tt.name, got, tt.want)
+		}
+		if !reflect.DeepEqual(c.args, tt.args) {
+			t.Errorf("%s: args = %#v, esperado %#v", tt.name, c.args, tt.args)
+		}
+		if c.simples != tt.simples {
+			t.Errorf("%s: simples = %v, esperado %v", tt.name, c.simples, tt.simples)
+		}
+	}
+}
+
+func TestFilterCompilerTree(t *testing.T) {
+	uf := &Filter{Field: "uf", Op: OpEq, Value: "SP"}
+	cnae := &Filter{Field: "cnaeFiscal", Op: OpEq, Value: "4711302"}
+	baixada := &Filter{Field: "situacaoCadastral", Op: OpEq, Value: "08"}
+	tests := []struct {
+		name string
+		f    *Filter
+		want string
+		args []interface{}
+	}{
+		{"and vazio", &Filter{And: []*Filter{}}, "TRUE", nil},
+		{
+			"and com or e not",
+			&Filter{And: []*Filter{uf, {Or: []*Filter{cnae, {Not: baixada}}}}},
+			"(e.uf = $1 AND (e.cnae_fiscal = $2 OR NOT COALESCE((e.situacao_cadastral = ANY($3)), false)))",
+			[]interface{}{"SP", "4711302", pq.Array([]string{"08", "8"})},
+		},
+		{
+			// Sem o COALESCE, NOT (NULL) exclui os estabelecimentos sem nome fantasia.
+			"not sobre coluna anulável",
+			&Filter{Not: &Filter{Field: "nomeFantasia", Op: OpPrefix, Value: "A"}},
+			"NOT COALESCE((e.nome_fantasia ILIKE $1), false)",
+			[]interface{}{"A%"},
+		},
+		{
+			"not de not",
+			&Filter{Not: &Filter{Not: uf}},
+			"NOT COALESCE((NOT COALESCE((e.uf = $1), false)), false)",
+			[]interface{}{"SP"},
+		},
+	}
+	for _, tt := range tests {
+		c := &filterCompiler{}
+		got, err := c.compile(tt.f)
+		if err != nil {
+			t.Errorf("%s: erro inesperado: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: SQL = %q, esperado %q", tt.name, got, tt.want)
+		}
+		if !reflect.DeepEqual(c.args, tt.args) {
+			t.Errorf("%s: args = %#v, esperado %#v", tt.name, c.args, tt.args)
+		}
+	}
+}
+
+func TestFilterCompilerErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		f    *Filter
+	}{
+		{"campo fora da lista", &Filter{Field: "cnae_fiscal; DROP TABLE empresas", Op: OpEq, Value: "1"}},
+		{"coluna em vez do campo", &Filter{Field: "e.uf", Op: OpEq, Value: "SP"}},
+		{"or vazio", &Filter{Or: []*Filter{}}},
+		{"nó sem nada", &Filter{}},
+		{"nó com and e predicado", &Filter{And: []*Filter{}, Field: "uf", Op: OpEq, Value: "SP"}},
+		{"filho nil", &Filter{And: []*Filter{nil}}},
+		{"in sem valores", &Filter{Field: "uf", Op: OpIn}},
+		{"range sem limites", &Filter{Field: "capitalSocial", Op: OpRange}},
+		{"número inválido", &Filter{Field: "capitalSocial", Op: OpEq, Value: "mil"}},
+		{"data inválida", &Filter{Field: "dataInicioAtividades", Op: OpEq, Value: "2024-02-30"}},
+		{"prefix em número", &Filter{Field: "capitalSocial", Op: OpPrefix, Value: "1"}},
+		{"operador desconhecido", &Filter{Field: "uf", Op: FilterOp(99), Value: "SP"}},
+	}
+	for _, tt := range tests {
+		c := &filterCompiler{}
+		_, err := c.compile(tt.f)
+		var verr *models.ValidationError
+		if !errors.As(err, &verr) || verr.Field != "where" {
+			t.Errorf("%s: erro = %v, esperado um ValidationError em where", tt.name, err)
+		}
+	}
+}
+
+// nested monta uma expressão com depth níveis (a raiz e depth-1 nots) terminando em um predicado.
+func nested(depth int) *Filter {
+	f := &Filter{Field: "uf", Op: OpEq, Value: "SP"}
+	for i := 1; i < depth; i++ {
+		f = &Filter{Not: f}
+	}
+	return f
+}
+
+// leaves monta um and com n predicados.
+func leaves(n int) *Filter {
+	f := &Filter{And: make([]*Filter, n)}
+	for i := range f.And {
+		f.And[i] = &Filter{Field: "uf", Op: OpEq, Value: "SP"}
+	}
+	return f
+}
+
+func TestCheckLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       *Filter
+		wantErr bool
+	}{
+		{"profundidade máxima", nested(MaxFilterDepth), false},
+		{"profundidade acima do máximo", nested(MaxFilterDepth + 1), true},
+		{"predicados no máximo", leaves(MaxFilterLeaves), false},
+		{"predicados acima do máximo", leaves(MaxFilterLeaves + 1), true},
+	}
+	for _, tt := range tests {
+		err := checkLimits(tt.f)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: erro = %v, esperado erro: %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"PADARIA", "PADARIA"},
+		{"50%", `50\%`},
+		{"A_B", `A\_B`},
+		{`C:\DADOS`, `C:\\DADOS`},
+		{`%_\`, `\%\_\\`},
+	}
+	for _, tt := range tests {
+		if got := escapeLike(tt.in); got != tt.want {
+			t.Errorf("escapeLike(%q) = %q, esperado %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCNAEsFilter(t *testing.T) {
+	codigos := []string{"4711302", "5611201"}
+	ecs := "e.cnpj IN (SELECT ecs.cnpj FROM estabelecimento_cnae_secundaria ecs WHERE ecs.cnae "
+	tests := []struct {
+		modo string
+		want string
+		args []interface{}
+	}{
+		{
+			"", "(e.cnae_fiscal = ANY($1) OR " + ecs + "= ANY($2)))",
+			[]interface{}{pq.Array(codigos), pq.Array(codigos)},
+		},
+		{
+			CNAEsTodos, "((e.cnae_fiscal = $1 OR " + ecs + "= $2)) AND (e.cnae_fiscal = $3 OR " + ecs + "= $4)))",
+			[]interface{}{"4711302", "4711302", "5611201", "5611201"},
+		},
+		{CNAEsPrincipal, "e.cnae_fiscal = ANY($1)", []interface{}{pq.Array(codigos)}},
+	}
+	for _, tt := range tests {
+		c := &filterCompiler{}
+		got, err := c.compile(cnaesFilter(codigos, tt.modo))
+		if err != nil {
+			t.Errorf("modo %q: erro inesperado: %v", tt.modo, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("modo %q: SQL = %q, esperado %q", tt.modo, got, tt.want)
+		}
+		if !reflect.DeepEqual(c.args, tt.args) {
+			t.Errorf("modo %q: args = %#v, esperado %#v", tt.modo, c.args, tt.args)
+		}
+	}
+}
+
+func TestProspeccaoFromFlatFilters(t *testing.T) {
+	inicio, _ := models.ParseReceitaDate("2020-01-01")
+	filters := map[string]interface{}{
+		"uf":                      "SP",
+		"razaoSocial":             "padaria",
+		"municipioNotIn":          []string{"7107"},
+		"minCapitalSocial":        10000.0,
+		"dataInicioAtividadesMin": inicio,
+		"opcaoSimples":            "S",
+		"where":                   &Filter{Field: "cnaeFiscal", Op: OpPrefix, Value: "47"},
+	}
+	q, err := prospeccaoFrom(filters)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	want := "WHERE (e.uf = $1 AND s.opcao_simples = $2 AND emp.razao_social ILIKE $3" +
+		" AND NOT COALESCE((e.municipio = ANY($4)), false) AND (emp.capital_social >= $5)" +
+		" AND (NULLIF(NULLIF(NULLIF(replace(e.data_inicio_atividades::text, '-', ''), ''), '0'), '00000000') >= $6)" +
+		" AND e.cnae_fiscal ILIKE $7)"
+	if !strings.HasSuffix(q.from, want) {
+		t.Errorf("FROM/WHERE = %q, esperado terminar com %q", q.from, want)
+	}
+	if !strings.Contains(q.from, "LEFT JOIN simples s") {
+		t.Errorf("FROM/WHERE = %q, esperado o LEFT JOIN com simples", q.from)
+	}
+	args := []interface{}{"SP", "S", "%padaria%", pq.Array([]string{"7107"}), 10000.0, "20200101", "47%"}
+	if !reflect.DeepEqual(q.args, args) {
+		t.Errorf("args = %#v, esperado %#v", q.args, args)
+	}
+
+	// Os limites valem para a expressão where.
+	if _, err := prospeccaoFrom(map[string]interface{}{"where": nested(MaxFilterDepth + 1)}); err == nil {
+		t.Error("prospeccaoFrom aceitou uma expressão acima do limite de níveis")
+	}
+}
+
+func TestCodeVariants(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want []string
+	}{
+		{[]string{"02"}, []string{"02", "2"}},
+		{[]string{"2"}, []string{"2", "02"}},
+		{[]string{"08", "8"}, []string{"08", "8"}},
+		{[]string{"10"}, []string{"10"}},
+		{[]string{""}, []string{""}},
+	}
+	for _, tt := range tests {
+		if got := codeVariants(tt.in, 2); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("codeVariants(%q) = %q, esperado %q", tt.in, got, tt.want)
+		}
+	}
+}
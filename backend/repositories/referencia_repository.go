@@ -0,0 +1,84 @@
+// neurocloser/backend/repositories/referencia_repository.go
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ReferenciaRepository define a interface para as tabelas de domínio da Receita (código -> descrição).
+type ReferenciaRepository interface {
+	GetReferenciaByCodigo(codigo string) (*models.Referencia, error)
+	GetReferenciasByCodigos(codigos []string) ([]*models.Referencia, error)
+}
+
+// referenciaRepository implementa ReferenciaRepository para PostgreSQL.
+// Todas as tabelas de domínio têm o mesmo formato (codigo, descricao); só muda o nome da tabela.
+type referenciaRepository struct {
+	db    *sqlx.DB
+	table string
+}
+
+// NewNaturezaJuridicaRepository cria o repositório da tabela 'natureza_juridica'.
+func NewNaturezaJuridicaRepository(db *sqlx.DB) ReferenciaRepository {
+	return &referenciaRepository{db: db, table: "natureza_juridica"}
+}
+
+// NewQualificacaoRepository cria o repositório da tabela 'qualificacao' (de sócios e responsáveis).
+func NewQualificacaoRepository(db *sqlx.DB) ReferenciaRepository {
+	return &referenciaRepository{db: db, table: "qualificacao"}
+}
+
+// NewPaisRepository cria o repositório da tabela 'pais'.
+func NewPaisRepository(db *sqlx.DB) ReferenciaRepository {
+	return &referenciaRepository{db: db, table: "pais"}
+}
+
+// NewMunicipioRepository cria o repositório da tabela 'municipio' (códigos de município da Receita, não do IBGE).
+func NewMunicipioRepository(db *sqlx.DB) ReferenciaRepository {
+	return &referenciaRepository{db: db, table: "municipio"}
+}
+
+// NewMotivoRepository cria o repositório da tabela 'motivo' (motivos de situação cadastral).
+func NewMotivoRepository(db *sqlx.DB) ReferenciaRepository {
+	return &referenciaRepository{db: db, table: "motivo"}
+}
+
+// GetReferenciaByCodigo busca uma descrição pelo código.
+func (r *referenciaRepository) GetReferenciaByCodigo(codigo string) (*models.Referencia, error) {
+	var ref models.Referencia
+	query := fmt.Sprintf("SELECT codigo, descricao FROM %s WHERE codigo = $1", r.table)
+	err := r.db.Get(&ref, query, codigo)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Código não encontrado, não é um erro para o chamador.
+		}
+		return nil, fmt.Errorf("erro ao buscar '%s' por código '%s': %w", r.table, codigo, err)
+	}
+	return &ref, nil
+}
+
+// GetReferenciasByCodigos busca múltiplas descrições por uma lista de códigos.
+func (r *referenciaRepository) GetReferenciasByCodigos(codigos []string) ([]*models.Referencia, error) {
+	if len(codigos) == 0 {
+		return []*models.Referencia{}, nil
+	}
+
+	var refs []*models.Referencia
+	query := fmt.Sprintf("SELECT codigo, descricao FROM %s WHERE codigo IN (?)", r.table)
+	query, args, err := sqlx.In(query, codigos)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar query IN para '%s': %w", r.table, err)
+	}
+	query = r.db.Rebind(query) // Rebind para o formato de placeholder do PostgreSQL ($1, $2, etc.)
+
+	err = r.db.Select(&refs, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar '%s' por códigos: %w", r.table, err)
+	}
+	return refs, nil
+}
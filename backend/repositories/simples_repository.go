@@ -0,0 +1,75 @@
+// neurocloser/backend/repositories/simples_repository.go
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SimplesRepository define a interface para operações de dados do Simples Nacional / MEI.
+type SimplesRepository interface {
+	GetSimplesByCNPJBasico(cnpjBasico string) (*models.Simples, error)
+	GetSimplesByCNPJBasicos(cnpjBasicos []string) ([]*models.Simples, error)
+}
+
+// simplesRepository implementa SimplesRepository para PostgreSQL.
+type simplesRepository struct {
+	db *sqlx.DB
+}
+
+// NewSimplesRepository cria uma nova instância de SimplesRepository.
+func NewSimplesRepository(db *sqlx.DB) SimplesRepository {
+	return &simplesRepository{db: db}
+}
+
+// GetSimplesByCNPJBasico busca a opção pelo Simples/MEI de uma empresa pelo CNPJ Básico.
+func (r *simplesRepository) GetSimplesByCNPJBasico(cnpjBasico string) (*models.Simples, error) {
+	var simples models.Simples
+	query := `
+		SELECT
+			cnpj_basico, opcao_simples, data_opcao_simples, data_exclusao_simples,
+			opcao_mei, data_opcao_mei, data_exclusao_mei
+		FROM simples
+		WHERE cnpj_basico = $1
+	`
+	err := r.db.Get(&simples, query, cnpjBasico)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Empresa que nunca optou pelo Simples nem pelo MEI
+		}
+		return nil, fmt.Errorf("erro ao buscar simples por CNPJ Básico %s: %w", cnpjBasico, err)
+	}
+	return &simples, nil
+}
+
+// GetSimplesByCNPJBasicos busca a opção pelo Simples/MEI de vários CNPJs básicos em uma única consulta.
+// Empresas que nunca optaram não têm linha na tabela 'simples'.
+func (r *simplesRepository) GetSimplesByCNPJBasicos(cnpjBasicos []string) ([]*models.Simples, error) {
+	if len(cnpjBasicos) == 0 {
+		return []*models.Simples{}, nil
+	}
+
+	var simples []*models.Simples
+	query := `
+		SELECT
+			cnpj_basico, opcao_simples, data_opcao_simples, data_exclusao_simples,
+			opcao_mei, data_opcao_mei, data_exclusao_mei
+		FROM simples
+		WHERE cnpj_basico IN (?)
+	`
+	query, args, err := sqlx.In(query, cnpjBasicos)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar query IN para simples: %w", err)
+	}
+	query = r.db.Rebind(query) // Rebind para o formato de placeholder do PostgreSQL ($1, $2, etc.)
+
+	err = r.db.Select(&simples, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar simples por CNPJs básicos: %w", err)
+	}
+	return simples, nil
+}
@@ -16,6 +16,23 @@ type SocioRepository interface {
 	GetMultiplesSociosByCNPJBasicos(cnpjBasicos []string) (map[string][]*models.Socio, error)
 }
 
+// socioColumns são as colunas de socios para models.Socio. Os campos opcionais que a Receita deixa em
+// branco (gravados vazios pelo importador) passam por NULLIF para chegarem como null ao GraphQL. Os
+// obrigatórios passam por COALESCE, porque os campos do model são string e as colunas aceitam NULL
+// (cnpj, por exemplo, fica NULL quando a matriz não está na base).
+const socioColumns = `
+			COALESCE(cnpj, '') AS cnpj, cnpj_basico,
+			COALESCE(identificador_de_socio, '') AS identificador_de_socio,
+			COALESCE(nome_socio, '') AS nome_socio,
+			COALESCE(cnpj_cpf_socio, '') AS cnpj_cpf_socio,
+			COALESCE(qualificacao_socio, '') AS qualificacao_socio,
+			data_entrada_sociedade,
+			NULLIF(pais, '') AS pais,
+			NULLIF(representante_legal, '') AS representante_legal,
+			NULLIF(nome_representante, '') AS nome_representante,
+			NULLIF(qualificacao_representante_legal, '') AS qualificacao_representante_legal,
+			NULLIF(faixa_etaria, '') AS faixa_etaria`
+
 // socioRepository implementa SocioRepository para PostgreSQL.
 type socioRepository struct {
 	db *sqlx.DB
@@ -28,12 +45,9 @@ func NewSocioRepository(db *sqlx.DB) SocioRepository {
 
 // GetSociosByCNPJBasico busca os sócios de uma empresa pelo CNPJ Básico.
 func (r *socioRepository) GetSociosByCNPJBasico(cnpjBasico string) ([]*models.Socio, error) {
-	var socios []*models.Socio
-	query := ` 
-		SELECT
-			cnpj, cnpj_basico, identificador_de_socio, nome_socio, cnpj_cpf_socio,
-			qualificacao_socio, data_entrada_sociedade, pais, representante_legal,
-			nome_representante, qualificacao_representante_legal, faixa_etaria
+	socios := []*models.Socio{}
+	query := `
+		SELECT ` + socioColumns + `
 		FROM socios
 		WHERE cnpj_basico = $1
 	`
@@ -51,10 +65,7 @@ func (r *socioRepository) GetMultiplesSociosByCNPJBasicos(cnpjBasicos []string)
 	}
 
 	query := `
-		SELECT
-			cnpj, cnpj_basico, identificador_de_socio, nome_socio, cnpj_cpf_socio,
-			qualificacao_socio, data_entrada_sociedade, pais, representante_legal,
-			nome_representante, qualificacao_representante_legal, faixa_etaria
+		SELECT ` + socioColumns + `
 		FROM socios
 		WHERE cnpj_basico IN (?)
 	`
@@ -0,0 +1,73 @@
+// neurocloser/backend/repositories/versao_dados_repository.go
+package repositories
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/edufilhocruz/neurocloser/backend/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// isoTimestamp formata um TIMESTAMPTZ como ISO 8601 em UTC.
+const isoTimestamp = `to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`
+
+// VersaoDadosRepository define a interface para consultas ao catálogo de cargas gravado pelo importador.
+type VersaoDadosRepository interface {
+	GetVersaoAtual() (*models.VersaoDados, error)
+}
+
+// versaoDadosRepository implementa VersaoDadosRepository para PostgreSQL.
+type versaoDadosRepository struct {
+	db *sqlx.DB
+}
+
+// NewVersaoDadosRepository cria uma nova instância de VersaoDadosRepository.
+func NewVersaoDadosRepository(db *sqlx.DB) VersaoDadosRepository {
+	return &versaoDadosRepository{db: db}
+}
+
+// GetVersaoAtual retorna a carga mais recente que ainda tem tabelas no ar, com todas as tabelas
+// no ar (que podem ter vindo de cargas diferentes) e seus arquivos de origem.
+// Retorna nil, nil se nenhuma carga foi feita pelo importador.
+func (r *versaoDadosRepository) GetVersaoAtual() (*models.VersaoDados, error) {
+	var versao models.VersaoDados
+	query := fmt.Sprintf(`
+		SELECT v.id, v.referencia, %s AS iniciada_em, %s AS concluida_em
+		FROM versoes_dados v
+		WHERE EXISTS (SELECT 1 FROM versoes_dados_tabelas t WHERE t.versao_id = v.id AND t.geracao = 'viva')
+		ORDER BY v.id DESC
+		LIMIT 1`, fmt.Sprintf(isoTimestamp, "v.iniciada_em"), fmt.Sprintf(isoTimestamp, "v.concluida_em"))
+
+	err := r.db.Get(&versao, query)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("erro ao buscar a versão dos dados: %w", err)
+	}
+
+	versao.Tabelas = []*models.VersaoTabela{}
+	query = fmt.Sprintf(`
+		SELECT t.tabela, v.referencia, t.linhas, t.rejeitadas, %s AS carregada_em
+		FROM versoes_dados_tabelas t
+		JOIN versoes_dados v ON v.id = t.versao_id
+		WHERE t.geracao = 'viva'
+		ORDER BY t.tabela`, fmt.Sprintf(isoTimestamp, "v.concluida_em"))
+	if err := r.db.Select(&versao.Tabelas, query); err != nil {
+		return nil, fmt.Errorf("erro ao buscar as tabelas da versão dos dados: %w", err)
+	}
+
+	versao.Arquivos = []*models.VersaoArquivo{}
+	query = `
+		SELECT a.tabela, a.arquivo, a.checksum, a.linhas
+		FROM versoes_dados_arquivos a
+		JOIN versoes_dados_tabelas t ON t.versao_id = a.versao_id AND t.tabela = a.tabela
+		WHERE t.geracao = 'viva'
+		ORDER BY a.tabela, a.arquivo`
+	if err := r.db.Select(&versao.Arquivos, query); err != nil {
+		return nil, fmt.Errorf("erro ao buscar os arquivos da versão dos dados: %w", err)
+	}
+	return &versao, nil
+}